@@ -6,32 +6,65 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/batch"
+	"github.com/srnnkls/gh-review/internal/diff"
 	"github.com/srnnkls/gh-review/internal/output"
+	"github.com/srnnkls/gh-review/internal/suggestion"
 	"github.com/srnnkls/gh-review/internal/templates"
 )
 
 var addCmd = &cobra.Command{
-	Use:   "add <number>",
+	Use:   "add [<number>]",
 	Short: "Add a draft comment",
 	Long: `Add a comment to your pending review.
 
-Creates a new pending review if none exists.`,
+Creates a new pending review if none exists. The anchor is checked against
+the PR diff first; use --no-validate to skip the check.
+
+With --suggest-file (or --suggest to read stdin), the comment carries a
+suggested change replacing lines --start-line through --line with the given
+text. The body, if any, is placed above the suggestion.
+
+With --editor, the body is written in $GH_EDITOR, $VISUAL or $EDITOR,
+starting from any --body or --template text, with the commented diff lines
+shown below for reference. Saving an empty body aborts.
+
+With --file-level, the comment is about the whole file rather than any of
+its lines, such as a rename, a binary file or generated code; it takes no
+--line.
+
+With --from-file, adds every comment in a JSONL, JSON array or YAML file
+(or stdin with "-") to the same pending review. Each record takes the keys
+path, line, start_line, side, start_side, file_level, and body or template;
+file_level records take no line or side keys.`,
 	Example: `  gh review add 123 -p src/main.go -l 42 -b "Consider error handling"
   gh review add 123 -R owner/repo -p src/main.go -l 42 -t naming
-  gh review add 123 -p src/main.go -l 50 --start-line 45 -b "Multi-line comment"`,
-	Args: cobra.ExactArgs(1),
+  gh review add 123 -p src/main.go -l 50 --start-line 45 -b "Multi-line comment"
+  gh review add 123 -p f.go -l 10 --start-line 8 --suggest-file fixed.txt
+  gh review add 123 -p f.go -l 10 --start-line 8 -b "Simpler:" --suggest < fixed.txt
+  gh review add 123 -p src/main.go -l 42 -e
+  gh review add 123 -p assets/logo.png --file-level -b "Can this be an SVG?"
+  gh review add 123 --from-file comments.jsonl
+  lint-to-jsonl | gh review add 123 --from-file -`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runAdd,
 }
 
 var (
-	addPath      string
-	addLine      int
-	addBody      string
-	addSide      string
-	addTemplate  string
-	addStartLine int
-	addStartSide string
-	addReviewID  string
+	addPath        string
+	addLine        int
+	addBody        string
+	addSide        string
+	addTemplate    string
+	addStartLine   int
+	addStartSide   string
+	addReviewID    string
+	addFromFile    string
+	addNoValidate  bool
+	addSuggestFile string
+	addSuggest     bool
+	addEditor      bool
+	addFileLevel   bool
 )
 
 func init() {
@@ -44,64 +77,74 @@ func init() {
 	addCmd.Flags().IntVar(&addStartLine, "start-line", 0, "Start line for multi-line comment")
 	addCmd.Flags().StringVar(&addStartSide, "start-side", "", "Start side for multi-line comment")
 	addCmd.Flags().StringVar(&addReviewID, "review-id", "", "Explicit review ID (GraphQL node ID)")
+	addCmd.Flags().StringVar(&addFromFile, "from-file", "", "Add comments from a JSONL, JSON or YAML file (\"-\" for stdin)")
+	addCmd.Flags().BoolVar(&addNoValidate, "no-validate", false, "Skip checking the anchor against the PR diff")
+	addCmd.Flags().StringVar(&addSuggestFile, "suggest-file", "", "Suggest replacing the commented lines with this file's contents")
+	addCmd.Flags().BoolVar(&addSuggest, "suggest", false, "Suggest replacing the commented lines with text read from stdin")
+	addCmd.Flags().BoolVarP(&addEditor, "editor", "e", false, "Write the comment body in your editor")
+	addCmd.Flags().BoolVar(&addFileLevel, "file-level", false, "Comment on the whole file instead of a line")
 
-	addCmd.MarkFlagRequired("path")
-	addCmd.MarkFlagRequired("line")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "path")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "line")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "body")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "template")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "suggest-file")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "suggest")
+	addCmd.MarkFlagsMutuallyExclusive("suggest-file", "suggest")
+	addCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
+	addCmd.MarkFlagsMutuallyExclusive("suggest", "editor")
+	for _, flag := range []string{"line", "start-line", "start-side", "side", "suggest-file", "suggest", "from-file"} {
+		addCmd.MarkFlagsMutuallyExclusive("file-level", flag)
+	}
 }
 
 func runAdd(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
+	pr, err := resolvePRArgs(args)
 	if err != nil {
 		return err
 	}
 
-	body := addBody
-	if addTemplate != "" {
-		tpl, ok := templates.Get(addTemplate)
-		if !ok {
-			return fmt.Errorf("unknown template %q (available: %v)", addTemplate, templates.List())
-		}
-		body = tpl
+	if addFromFile != "" {
+		return runAddBatch(cmd, pr)
 	}
-	if body == "" {
-		return fmt.Errorf("body is required (use -b or -t)")
+
+	if addFileLevel {
+		if addPath == "" {
+			return fmt.Errorf("--path is required")
+		}
+	} else if addPath == "" || addLine == 0 {
+		return fmt.Errorf("--path and --line are required (or use --file-level or --from-file)")
 	}
 
-	client, err := api.NewClient()
+	replacement, hasSuggestion, err := readSuggestion(addSuggestFile, addSuggest)
 	if err != nil {
 		return err
 	}
 
-	var reviewID string
+	var body string
+	switch {
+	case hasSuggestion:
+		body, err = suggestedBody(addBody, addTemplate, replacement)
+	case addEditor && addBody == "" && addTemplate == "":
+		// The body is written in the editor.
+	default:
+		body, err = commentBody(addBody, addTemplate)
+	}
+	if err != nil {
+		return err
+	}
 
-	if addReviewID != "" {
-		reviewID = addReviewID
-	} else {
-		review, err := client.LatestPendingReview(pr, api.PendingReviewsOptions{})
-		if err != nil {
-			prIdentity, resolveErr := client.ResolvePR(pr)
-			if resolveErr != nil {
-				return resolveErr
-			}
-			result, createErr := client.CreateReview(api.CreateReviewInput{
-				PRNodeID:  prIdentity.NodeID,
-				CommitOID: prIdentity.HeadRefOID,
-			})
-			if createErr != nil {
-				return createErr
-			}
-			reviewID = result.ID
-		} else {
-			reviewID = review.ID
-		}
+	client, err := api.NewClient()
+	if err != nil {
+		return err
 	}
 
 	input := api.AddThreadInput{
-		ReviewID: reviewID,
-		Path:     addPath,
-		Line:     addLine,
-		Side:     addSide,
-		Body:     body,
+		Path:      addPath,
+		Line:      addLine,
+		Side:      addSide,
+		Body:      body,
+		FileLevel: addFileLevel,
 	}
 	if addStartLine > 0 {
 		input.StartLine = &addStartLine
@@ -110,14 +153,46 @@ func runAdd(cmd *cobra.Command, args []string) error {
 		input.StartSide = &addStartSide
 	}
 
+	if hasSuggestion {
+		if err := suggestion.CheckAnchor(anchorFor(&input)); err != nil {
+			return err
+		}
+	}
+
+	var prDiff *diff.Diff
+	if !addNoValidate || addEditor {
+		prDiff, err = client.PullRequestDiff(pr)
+		if err != nil {
+			return err
+		}
+	}
+	if !addNoValidate {
+		if err := prDiff.Validate(anchorFor(&input)); err != nil {
+			return err
+		}
+	}
+
+	if addEditor {
+		input.Body, err = composeBody(body, anchorContext(prDiff, &input))
+		if err != nil {
+			return err
+		}
+	}
+
+	input.ReviewID, err = pendingReviewID(client, pr, addReviewID)
+	if err != nil {
+		return err
+	}
+
 	_, err = client.AddThread(input)
 	if err != nil {
 		return err
 	}
 
 	result := output.AddResult{
-		Path: addPath,
-		Line: addLine,
+		Path:      addPath,
+		Line:      addLine,
+		FileLevel: addFileLevel,
 	}
 
 	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
@@ -127,3 +202,201 @@ func runAdd(cmd *cobra.Command, args []string) error {
 
 	return formatter.Format(result)
 }
+
+// runAddBatch adds every record from --from-file to a single pending review,
+// reporting each record's outcome instead of stopping at the first failure.
+func runAddBatch(cmd *cobra.Command, pr *api.PRRef) error {
+	records, err := readBatchRecords(addFromFile)
+	if err != nil {
+		return err
+	}
+
+	result := output.BatchAddResult{
+		Items: make([]output.BatchAddItem, len(records)),
+	}
+	inputs := make([]*api.AddThreadInput, len(records))
+
+	for i, rec := range records {
+		result.Items[i] = output.BatchAddItem{
+			Record:    i + 1,
+			Path:      rec.Path,
+			Line:      rec.Line,
+			FileLevel: rec.FileLevel,
+		}
+
+		body, err := commentBody(rec.Body, rec.Template)
+		if err != nil {
+			result.Items[i].Error = err.Error()
+			continue
+		}
+
+		input := &api.AddThreadInput{
+			Path:      rec.Path,
+			Line:      rec.Line,
+			Side:      rec.Side,
+			Body:      body,
+			FileLevel: rec.FileLevel,
+		}
+		if rec.StartLine > 0 {
+			startLine := rec.StartLine
+			input.StartLine = &startLine
+		}
+		if rec.StartSide != "" {
+			startSide := rec.StartSide
+			input.StartSide = &startSide
+		}
+		inputs[i] = input
+	}
+
+	if result.Failed() == len(records) {
+		return formatBatchResult(cmd, result)
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if !addNoValidate {
+		prDiff, err := client.PullRequestDiff(pr)
+		if err != nil {
+			return err
+		}
+		for i, input := range inputs {
+			if input == nil {
+				continue
+			}
+			if err := prDiff.Validate(anchorFor(input)); err != nil {
+				result.Items[i].Error = err.Error()
+				inputs[i] = nil
+			}
+		}
+	}
+
+	if result.Failed() < len(records) {
+		if err := addThreads(client, pr, addReviewID, &result, inputs); err != nil {
+			return err
+		}
+	}
+
+	return formatBatchResult(cmd, result)
+}
+
+// anchorFor describes where input would place its comment in the diff.
+func anchorFor(input *api.AddThreadInput) diff.Anchor {
+	anchor := diff.Anchor{
+		Path:      input.Path,
+		Line:      input.Line,
+		Side:      input.Side,
+		FileLevel: input.FileLevel,
+	}
+	if input.StartLine != nil {
+		anchor.StartLine = *input.StartLine
+	}
+	if input.StartSide != nil {
+		anchor.StartSide = *input.StartSide
+	}
+	return anchor
+}
+
+// addThreads adds each non-nil input to one pending review, recording the
+// outcome on the matching result item.
+func addThreads(client *api.Client, pr *api.PRRef, explicitReviewID string, result *output.BatchAddResult, inputs []*api.AddThreadInput) error {
+	reviewID, err := pendingReviewID(client, pr, explicitReviewID)
+	if err != nil {
+		return err
+	}
+	result.ReviewID = reviewID
+
+	for i, input := range inputs {
+		if input == nil {
+			continue
+		}
+		input.ReviewID = reviewID
+
+		added, err := client.AddThread(*input)
+		if err != nil {
+			result.Items[i].Error = err.Error()
+			continue
+		}
+		result.Items[i].ThreadID = added.ThreadID
+	}
+
+	return nil
+}
+
+// formatBatchResult prints a batch outcome and fails the command when any
+// record could not be added.
+func formatBatchResult(cmd *cobra.Command, result output.BatchAddResult) error {
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	if err := formatter.Format(result); err != nil {
+		return err
+	}
+
+	if failed := result.Failed(); failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d comments failed", failed, len(result.Items))
+	}
+
+	return nil
+}
+
+func readBatchRecords(name string) ([]batch.Record, error) {
+	if name == "-" {
+		return batch.Parse(os.Stdin, name)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return batch.Parse(f, name)
+}
+
+// commentBody returns the template text when a template is named, otherwise
+// the literal body.
+func commentBody(body, template string) (string, error) {
+	if template != "" {
+		tpl, ok := templates.Get(template)
+		if !ok {
+			return "", fmt.Errorf("unknown template %q (available: %v)", template, templates.List())
+		}
+		body = tpl
+	}
+	if body == "" {
+		return "", fmt.Errorf("body is required (set a body or template)")
+	}
+	return body, nil
+}
+
+// pendingReviewID returns the explicit review ID when given, otherwise the
+// viewer's latest pending review, creating one if none exists.
+func pendingReviewID(client *api.Client, pr *api.PRRef, explicit string) (string, error) {
+	if explicit != "" {
+		return explicit, nil
+	}
+
+	review, err := client.LatestPendingReview(pr, api.PendingReviewsOptions{})
+	if err == nil {
+		return review.ID, nil
+	}
+
+	prIdentity, err := client.ResolvePR(pr)
+	if err != nil {
+		return "", err
+	}
+	result, err := client.CreateReview(api.CreateReviewInput{
+		PRNodeID:  prIdentity.NodeID,
+		CommitOID: prIdentity.HeadRefOID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCommentBody(t *testing.T) {
+	t.Run("literal body", func(t *testing.T) {
+		got, err := commentBody("Fix this", "")
+		if err != nil {
+			t.Fatalf("commentBody() unexpected error: %v", err)
+		}
+		if got != "Fix this" {
+			t.Errorf("body = %q, want %q", got, "Fix this")
+		}
+	})
+
+	t.Run("template wins over body", func(t *testing.T) {
+		got, err := commentBody("ignored", "security")
+		if err != nil {
+			t.Fatalf("commentBody() unexpected error: %v", err)
+		}
+		if !strings.Contains(got, "Security") {
+			t.Errorf("body = %q, want the security template", got)
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := commentBody("", "nope")
+		if err == nil || !strings.Contains(err.Error(), "unknown template") {
+			t.Errorf("commentBody() error = %v, want unknown template", err)
+		}
+	})
+
+	t.Run("neither body nor template", func(t *testing.T) {
+		_, err := commentBody("", "")
+		if err == nil {
+			t.Error("commentBody() expected error for empty body")
+		}
+	})
+}
+
+func TestAddCmdFlags(t *testing.T) {
+	if addCmd.Flags().Lookup("from-file") == nil {
+		t.Error("add: from-file flag not registered")
+	}
+}
+
+func TestSuggestedBody(t *testing.T) {
+	t.Run("suggestion alone", func(t *testing.T) {
+		got, err := suggestedBody("", "", "return nil\n")
+		if err != nil {
+			t.Fatalf("suggestedBody() unexpected error: %v", err)
+		}
+		if got != "```suggestion\nreturn nil\n```" {
+			t.Errorf("body = %q", got)
+		}
+	})
+
+	t.Run("body above suggestion", func(t *testing.T) {
+		got, err := suggestedBody("Simpler:", "", "return nil")
+		if err != nil {
+			t.Fatalf("suggestedBody() unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(got, "Simpler:\n\n```suggestion") {
+			t.Errorf("body = %q, want the body before the suggestion", got)
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := suggestedBody("", "nope", "return nil")
+		if err == nil {
+			t.Error("suggestedBody() expected error for unknown template")
+		}
+	})
+}
+
+func TestReadSuggestion(t *testing.T) {
+	t.Run("not requested", func(t *testing.T) {
+		_, ok, err := readSuggestion("", false)
+		if err != nil || ok {
+			t.Errorf("readSuggestion() = ok %v, err %v; want no suggestion", ok, err)
+		}
+	})
+
+	t.Run("from file", func(t *testing.T) {
+		name := filepath.Join(t.TempDir(), "fixed.txt")
+		if err := os.WriteFile(name, []byte("a := 1\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		got, ok, err := readSuggestion(name, false)
+		if err != nil || !ok {
+			t.Fatalf("readSuggestion() = ok %v, err %v", ok, err)
+		}
+		if got != "a := 1\n" {
+			t.Errorf("text = %q", got)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, _, err := readSuggestion(filepath.Join(t.TempDir(), "missing"), false)
+		if err == nil {
+			t.Error("readSuggestion() expected error for a missing file")
+		}
+	})
+}
@@ -0,0 +1,419 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+	"github.com/srnnkls/gh-review/internal/suggestion"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply [<number>]",
+	Short: "Apply suggested changes to the working tree",
+	Long: `Apply suggested changes from review threads to the local checkout.
+
+Takes the latest suggestion in each unresolved thread, or in the threads
+named with --thread/--comment (a --comment picks that comment's suggestion).
+Each suggestion is placed by the lines it was written against: if the code
+moved, it follows it; if the code changed, the suggestion is reported as a
+conflict and the file is left alone.
+
+Run from a checkout of the PR branch. With --commit, the changed files are
+committed; --reply then answers each applied thread with "Applied in <sha>",
+and --resolve resolves it.`,
+	Example: `  gh review apply 123 --dry-run
+  gh review apply 123
+  gh review apply 123 -c PRRC_xxx --commit --reply --resolve`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runApply,
+}
+
+var (
+	applyThreads  []string
+	applyComments []string
+	applyDryRun   bool
+	applyCommit   bool
+	applyMessage  string
+	applyReply    bool
+	applyResolve  bool
+)
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().StringSliceVar(&applyThreads, "thread", nil, "Threads whose suggestions to apply (t3 or node ID)")
+	applyCmd.Flags().StringSliceVarP(&applyComments, "comment", "c", nil, "Comments whose suggestions to apply (c3.2, comment ID, URL or node ID)")
+	applyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Check which suggestions apply without changing files")
+	applyCmd.Flags().BoolVar(&applyCommit, "commit", false, "Commit the changed files")
+	applyCmd.Flags().StringVarP(&applyMessage, "message", "m", "Apply suggestions from code review", "Commit message for --commit")
+	applyCmd.Flags().BoolVar(&applyReply, "reply", false, "Reply \"Applied in <sha>\" to each applied thread (requires --commit)")
+	applyCmd.Flags().BoolVar(&applyResolve, "resolve", false, "Resolve each applied thread")
+
+	applyCmd.MarkFlagsMutuallyExclusive("dry-run", "commit")
+	applyCmd.MarkFlagsMutuallyExclusive("dry-run", "reply")
+	applyCmd.MarkFlagsMutuallyExclusive("dry-run", "resolve")
+}
+
+// suggestionCandidate is a thread's suggestion together with where it
+// applies.
+type suggestionCandidate struct {
+	thread  *api.Thread
+	comment *api.ThreadComment
+	change  suggestion.Change
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args, joinRefs(applyThreads, applyComments)...)
+	if err != nil {
+		return err
+	}
+
+	if applyReply && !applyCommit {
+		return fmt.Errorf("--reply requires --commit so the reply can name the commit")
+	}
+
+	root := gitTopLevel()
+	if root == "" {
+		return fmt.Errorf("apply must run inside a git checkout of the pull request")
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	identity, err := client.ResolvePR(pr)
+	if err != nil {
+		return err
+	}
+	if head, err := runGit(root, "rev-parse", "HEAD"); err == nil && head != identity.HeadRefOID {
+		fmt.Fprintf(os.Stderr, "Warning: local HEAD %s is not the PR head %s; suggestions are placed by matching their original lines.\n", shortSHA(head), shortSHA(identity.HeadRefOID))
+	}
+
+	threads, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+	if err != nil {
+		return err
+	}
+
+	candidates, items, err := suggestionCandidates(threads.Threads, applyThreads, applyComments)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	if len(candidates) == 0 && len(items) == 0 {
+		return formatter.Format(output.NoOpResult{Message: "No suggestions to apply"})
+	}
+
+	result := output.ApplyResult{DryRun: applyDryRun}
+	var dirty func(string) bool
+	if applyCommit {
+		dirty = func(path string) bool {
+			status, err := runGit(root, "status", "--porcelain", "--", path)
+			return err != nil || status != ""
+		}
+	}
+	result.Items = append(applyCandidates(root, candidates, !applyDryRun, dirty), items...)
+
+	if applyCommit && result.Applied() > 0 {
+		sha, err := commitApplied(root, applyMessage, result.Items)
+		if err != nil {
+			return err
+		}
+		result.Commit = sha
+	}
+
+	for i := range result.Items {
+		item := &result.Items[i]
+		if !item.Applied || applyDryRun {
+			continue
+		}
+		if applyReply {
+			if _, err := client.ReplyThread(api.ReplyThreadInput{
+				ThreadID: item.ThreadID,
+				Body:     "Applied in " + result.Commit,
+			}); err != nil {
+				item.Error = err.Error()
+				continue
+			}
+			item.Replied = true
+		}
+		if applyResolve {
+			if _, err := client.ResolveThread(item.ThreadID); err != nil {
+				item.Error = err.Error()
+				continue
+			}
+			item.Resolved = true
+		}
+	}
+
+	if err := formatter.Format(result); err != nil {
+		return err
+	}
+
+	if failed := result.Failed(); failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d suggestions failed", failed, len(result.Items))
+	}
+
+	return nil
+}
+
+// suggestionCandidates picks the suggestion to apply from each selected
+// thread. Without IDs, every unresolved thread with a suggestion is used and
+// threads without one are skipped silently; named threads that cannot be
+// applied are returned as failed items instead.
+func suggestionCandidates(threads []*api.Thread, threadIDs, commentIDs []string) ([]suggestionCandidate, []output.ApplyItem, error) {
+	type selection struct {
+		thread  *api.Thread
+		comment string
+	}
+
+	var selected []selection
+	explicit := len(threadIDs) > 0 || len(commentIDs) > 0
+	if explicit {
+		// A named comment decides its thread's suggestion, so comments go
+		// first and a thread is only ever selected once.
+		seen := make(map[string]bool)
+		for _, id := range commentIDs {
+			id = strings.TrimSpace(id)
+			ref, err := api.ParseRef(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			t, c := ref.Find(threads)
+			if t == nil {
+				return nil, nil, fmt.Errorf("no review thread found for comment %s", id)
+			}
+			if !seen[t.ID] {
+				seen[t.ID] = true
+				s := selection{thread: t}
+				if c != nil {
+					s.comment = c.ID
+				}
+				selected = append(selected, s)
+			}
+		}
+		for _, id := range threadIDs {
+			id = strings.TrimSpace(id)
+			t := findThread(threads, id, "")
+			if t == nil {
+				return nil, nil, fmt.Errorf("no review thread %s", id)
+			}
+			if !seen[t.ID] {
+				seen[t.ID] = true
+				selected = append(selected, selection{thread: t})
+			}
+		}
+	} else {
+		for _, t := range threads {
+			if !t.IsResolved {
+				selected = append(selected, selection{thread: t})
+			}
+		}
+	}
+
+	var candidates []suggestionCandidate
+	var failed []output.ApplyItem
+	for _, s := range selected {
+		comment, replacement := threadSuggestion(s.thread, s.comment)
+
+		var reason string
+		switch {
+		case comment == nil:
+			reason = "no suggestion in thread"
+		case strings.EqualFold(s.thread.DiffSide, "LEFT"):
+			reason = "suggestion is on the old side of the diff"
+		}
+		if reason != "" {
+			if explicit {
+				item := output.ApplyItem{ThreadID: s.thread.ID, Path: s.thread.Path, Line: s.thread.Line, Error: reason}
+				if comment != nil {
+					item.CommentID = comment.ID
+				}
+				failed = append(failed, item)
+			}
+			continue
+		}
+
+		candidates = append(candidates, suggestionCandidate{
+			thread:  s.thread,
+			comment: comment,
+			change:  threadChange(s.thread, replacement),
+		})
+	}
+
+	return candidates, failed, nil
+}
+
+// threadSuggestion returns the comment whose suggestion to apply: the named
+// comment, or the last one in the thread that carries a suggestion.
+func threadSuggestion(t *api.Thread, commentID string) (*api.ThreadComment, string) {
+	for i := len(t.Comments) - 1; i >= 0; i-- {
+		c := t.Comments[i]
+		if commentID != "" && c.ID != commentID {
+			continue
+		}
+		if found := suggestion.Parse(c.Body); len(found) > 0 {
+			return c, found[0]
+		}
+		if commentID != "" {
+			return nil, ""
+		}
+	}
+	return nil, ""
+}
+
+// threadChange anchors a suggestion at the thread's current lines, or its
+// original lines when outdated, along with the code it was written against.
+func threadChange(t *api.Thread, replacement string) suggestion.Change {
+	change := suggestion.Change{
+		StartLine:   t.StartLine,
+		Line:        t.Line,
+		Replacement: replacement,
+	}
+	if t.IsOutdated || t.Line == 0 {
+		change.StartLine = t.OriginalStartLine
+		change.Line = t.OriginalLine
+	}
+
+	n := 1
+	if t.OriginalStartLine > 0 && t.OriginalLine >= t.OriginalStartLine {
+		n = t.OriginalLine - t.OriginalStartLine + 1
+	}
+	change.Original = suggestion.OriginalLines(t.DiffHunk, n)
+	return change
+}
+
+// applyCandidates applies suggestions file by file under root, writing each
+// file only when write is set. Files for which dirty reports uncommitted
+// changes are left alone.
+func applyCandidates(root string, candidates []suggestionCandidate, write bool, dirty func(string) bool) []output.ApplyItem {
+	items := make([]output.ApplyItem, len(candidates))
+	byPath := make(map[string][]int)
+	var paths []string
+	for i, c := range candidates {
+		items[i] = output.ApplyItem{
+			ThreadID:  c.thread.ID,
+			CommentID: c.comment.ID,
+			Path:      c.thread.Path,
+			StartLine: c.change.StartLine,
+			Line:      c.change.Line,
+		}
+		if _, ok := byPath[c.thread.Path]; !ok {
+			paths = append(paths, c.thread.Path)
+		}
+		byPath[c.thread.Path] = append(byPath[c.thread.Path], i)
+	}
+
+	for _, path := range paths {
+		indexes := byPath[path]
+		fail := func(err error) {
+			for _, i := range indexes {
+				items[i].Error = err.Error()
+			}
+		}
+
+		if dirty != nil && dirty(path) {
+			fail(fmt.Errorf("%s has uncommitted changes", path))
+			continue
+		}
+
+		name := filepath.Join(root, filepath.FromSlash(path))
+		info, err := os.Stat(name)
+		if err != nil {
+			fail(err)
+			continue
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			fail(err)
+			continue
+		}
+
+		changes := make([]suggestion.Change, len(indexes))
+		for j, i := range indexes {
+			changes[j] = candidates[i].change
+		}
+		updated, outcomes := suggestion.Apply(string(content), changes)
+
+		applied := 0
+		for j, i := range indexes {
+			if outcomes[j].Err != nil {
+				items[i].Error = outcomes[j].Err.Error()
+				continue
+			}
+			items[i].StartLine = outcomes[j].StartLine
+			items[i].Line = outcomes[j].Line
+			if items[i].StartLine == items[i].Line {
+				items[i].StartLine = 0
+			}
+			items[i].Applied = true
+			applied++
+		}
+
+		if write && applied > 0 {
+			if err := os.WriteFile(name, []byte(updated), info.Mode().Perm()); err != nil {
+				for _, i := range indexes {
+					items[i].Applied = false
+				}
+				fail(err)
+			}
+		}
+	}
+
+	return items
+}
+
+// commitApplied commits the files holding applied suggestions and returns
+// the new commit's SHA.
+func commitApplied(root, message string, items []output.ApplyItem) (string, error) {
+	args := []string{"commit", "--quiet", "-m", message, "--"}
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if item.Applied && !seen[item.Path] {
+			seen[item.Path] = true
+			args = append(args, item.Path)
+		}
+	}
+
+	if _, err := runGit(root, args...); err != nil {
+		return "", err
+	}
+	return runGit(root, "rev-parse", "HEAD")
+}
+
+// runGit runs git in dir and returns its trimmed output, folding stderr into
+// the error.
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func suggestionThreads() []*api.Thread {
+	return []*api.Thread{
+		{
+			ID: "PRRT_a", Path: "main.go", Line: 4, OriginalLine: 4, DiffSide: "RIGHT",
+			DiffHunk: "@@ -1,3 +1,4 @@\n package main\n \n func f() {\n+\ta := 1",
+			Comments: []*api.ThreadComment{
+				{ID: "PRRC_1", Body: "```suggestion\n\ta := 2\n```"},
+				{ID: "PRRC_2", Body: "Better:\n```suggestion\n\ta := 3\n```"},
+				{ID: "PRRC_3", Body: "agreed"},
+			},
+		},
+		{
+			ID: "PRRT_b", Path: "main.go", Line: 1, DiffSide: "RIGHT",
+			Comments: []*api.ThreadComment{{ID: "PRRC_4", Body: "no suggestion here"}},
+		},
+		{
+			ID: "PRRT_c", Path: "main.go", Line: 2, DiffSide: "LEFT",
+			Comments: []*api.ThreadComment{{ID: "PRRC_5", Body: "```suggestion\nx\n```"}},
+		},
+		{
+			ID: "PRRT_d", Path: "main.go", Line: 1, IsResolved: true, DiffSide: "RIGHT",
+			Comments: []*api.ThreadComment{{ID: "PRRC_6", Body: "```suggestion\nx\n```"}},
+		},
+	}
+}
+
+func TestSuggestionCandidates(t *testing.T) {
+	t.Run("unresolved threads use their latest suggestion", func(t *testing.T) {
+		candidates, failed, err := suggestionCandidates(suggestionThreads(), nil, nil)
+		if err != nil {
+			t.Fatalf("suggestionCandidates() unexpected error: %v", err)
+		}
+		if len(failed) != 0 {
+			t.Errorf("failed = %v, want none without explicit IDs", failed)
+		}
+		if len(candidates) != 1 {
+			t.Fatalf("got %d candidates, want 1", len(candidates))
+		}
+		if candidates[0].comment.ID != "PRRC_2" {
+			t.Errorf("comment = %s, want the latest suggestion PRRC_2", candidates[0].comment.ID)
+		}
+		if candidates[0].change.Replacement != "\ta := 3\n" {
+			t.Errorf("replacement = %q", candidates[0].change.Replacement)
+		}
+		if len(candidates[0].change.Original) != 1 || candidates[0].change.Original[0] != "\ta := 1" {
+			t.Errorf("original = %q, want the commented line from the hunk", candidates[0].change.Original)
+		}
+	})
+
+	t.Run("named comment picks its suggestion", func(t *testing.T) {
+		candidates, _, err := suggestionCandidates(suggestionThreads(), []string{"PRRT_a"}, []string{"PRRC_1"})
+		if err != nil {
+			t.Fatalf("suggestionCandidates() unexpected error: %v", err)
+		}
+		if len(candidates) != 1 || candidates[0].comment.ID != "PRRC_1" {
+			t.Fatalf("candidates = %v, want only PRRC_1", candidates)
+		}
+	})
+
+	t.Run("named threads that cannot apply fail", func(t *testing.T) {
+		candidates, failed, err := suggestionCandidates(suggestionThreads(), []string{"PRRT_b", "PRRT_c"}, nil)
+		if err != nil {
+			t.Fatalf("suggestionCandidates() unexpected error: %v", err)
+		}
+		if len(candidates) != 0 || len(failed) != 2 {
+			t.Fatalf("got %d candidates and %d failures, want 0 and 2", len(candidates), len(failed))
+		}
+	})
+
+	t.Run("unknown thread", func(t *testing.T) {
+		_, _, err := suggestionCandidates(suggestionThreads(), []string{"PRRT_x"}, nil)
+		if err == nil {
+			t.Error("suggestionCandidates() expected error for an unknown thread")
+		}
+	})
+}
+
+func TestApplyCandidates(t *testing.T) {
+	root := t.TempDir()
+	name := filepath.Join(root, "main.go")
+	original := "package main\n\nfunc f() {\n\ta := 1\n}\n"
+	if err := os.WriteFile(name, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	candidates, _, err := suggestionCandidates(suggestionThreads(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("dry run leaves the file alone", func(t *testing.T) {
+		items := applyCandidates(root, candidates, false, nil)
+		if !items[0].Applied {
+			t.Fatalf("item not applicable: %s", items[0].Error)
+		}
+		got, _ := os.ReadFile(name)
+		if string(got) != original {
+			t.Errorf("file changed during dry run: %q", got)
+		}
+	})
+
+	t.Run("dirty file is refused", func(t *testing.T) {
+		items := applyCandidates(root, candidates, true, func(string) bool { return true })
+		if items[0].Applied || items[0].Error == "" {
+			t.Errorf("item = %+v, want an uncommitted-changes error", items[0])
+		}
+	})
+
+	t.Run("writes the suggestion", func(t *testing.T) {
+		items := applyCandidates(root, candidates, true, nil)
+		if !items[0].Applied {
+			t.Fatalf("item not applied: %s", items[0].Error)
+		}
+		got, _ := os.ReadFile(name)
+		if want := "package main\n\nfunc f() {\n\ta := 3\n}\n"; string(got) != want {
+			t.Errorf("file = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("conflict once the code changed", func(t *testing.T) {
+		items := applyCandidates(root, candidates, true, nil)
+		if items[0].Applied || items[0].Error == "" {
+			t.Errorf("item = %+v, want a conflict", items[0])
+		}
+	})
+}
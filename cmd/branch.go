@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+// resolvePRArgs resolves the PR from the first argument. Without one, the
+// PR of the first comment URL among refs is used, and failing that the PR
+// of the checked-out branch.
+func resolvePRArgs(args []string, refs ...string) (*api.PRRef, error) {
+	if len(args) > 0 {
+		return resolvePR(args[0])
+	}
+	if pr, err := prFromRefs(refs); pr != nil || err != nil {
+		return pr, err
+	}
+	return currentBranchPR()
+}
+
+// currentBranchPR finds the single open PR whose head is the checked-out
+// branch, in the repository selected by -R or the git context.
+func currentBranchPR() (*api.PRRef, error) {
+	owner, name, err := api.ParseRepo(repoFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := currentBranchHead("")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	prs, err := client.OpenPRsForHead(owner, name, head)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := selectBranchPR(prs, head)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.PRRef{Owner: owner, Repo: name, Number: number}, nil
+}
+
+// selectBranchPR picks the PR for head, failing unless exactly one matches.
+func selectBranchPR(prs []*api.BranchPR, head api.BranchHead) (int, error) {
+	switch len(prs) {
+	case 0:
+		return 0, fmt.Errorf("no open pull request found for branch %s; pass a PR number", head)
+	case 1:
+		return prs[0].Number, nil
+	}
+
+	matches := make([]string, len(prs))
+	for i, pr := range prs {
+		matches[i] = fmt.Sprintf("#%d (%s:%s)", pr.Number, pr.HeadOwner, head.Branch)
+	}
+	return 0, fmt.Errorf("multiple open pull requests found for branch %s: %s; pass a PR number",
+		head, strings.Join(matches, ", "))
+}
+
+// currentBranchHead returns the head the branch checked out in dir is
+// pushed to. The branch name comes from its upstream when one is set, and
+// the owner from the URL of the remote it pushes to, which is what tells a
+// fork's owner:branch head apart from the base repository's.
+func currentBranchHead(dir string) (api.BranchHead, error) {
+	branch, err := runGit(dir, "symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil || branch == "" {
+		return api.BranchHead{}, fmt.Errorf("could not determine the current branch (detached HEAD?); pass a PR number")
+	}
+
+	head := api.BranchHead{Branch: branch}
+	if merge := gitConfig(dir, "branch."+branch+".merge"); merge != "" {
+		head.Branch = strings.TrimPrefix(merge, "refs/heads/")
+	}
+
+	remote := gitConfig(dir, "branch."+branch+".pushRemote")
+	if remote == "" {
+		remote = gitConfig(dir, "remote.pushDefault")
+	}
+	if remote == "" {
+		remote = gitConfig(dir, "branch."+branch+".remote")
+	}
+	if remote != "" && remote != "." {
+		if url, err := runGit(dir, "remote", "get-url", remote); err == nil {
+			if repo, err := repository.Parse(url); err == nil {
+				head.Owner = repo.Owner
+			}
+		}
+	}
+
+	return head, nil
+}
+
+// gitConfig returns a git config value, or "" when it is unset.
+func gitConfig(dir, key string) string {
+	value, err := runGit(dir, "config", "--get", key)
+	if err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func TestSelectBranchPR(t *testing.T) {
+	head := api.BranchHead{Owner: "octocat", Branch: "fix"}
+
+	t.Run("single match", func(t *testing.T) {
+		got, err := selectBranchPR([]*api.BranchPR{{Number: 7}}, head)
+		if err != nil {
+			t.Fatalf("selectBranchPR() unexpected error: %v", err)
+		}
+		if got != 7 {
+			t.Errorf("selectBranchPR() = %d, want 7", got)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		_, err := selectBranchPR(nil, head)
+		if err == nil || !strings.Contains(err.Error(), "octocat:fix") {
+			t.Errorf("selectBranchPR() error = %v, want one naming octocat:fix", err)
+		}
+	})
+
+	t.Run("multiple matches", func(t *testing.T) {
+		_, err := selectBranchPR([]*api.BranchPR{
+			{Number: 7, HeadOwner: "owner"},
+			{Number: 9, HeadOwner: "octocat"},
+		}, api.BranchHead{Branch: "fix"})
+		if err == nil {
+			t.Fatal("selectBranchPR() expected error for multiple matches")
+		}
+		for _, want := range []string{"#7 (owner:fix)", "#9 (octocat:fix)"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("error = %q, want containing %q", err.Error(), want)
+			}
+		}
+	})
+}
+
+func TestCurrentBranchHead(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		if _, err := runGit(dir, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("init", "--quiet", "--initial-branch=local-fix")
+
+	head, err := currentBranchHead(dir)
+	if err != nil {
+		t.Fatalf("currentBranchHead() unexpected error: %v", err)
+	}
+	if head != (api.BranchHead{Branch: "local-fix"}) {
+		t.Errorf("currentBranchHead() = %+v, want the local branch without owner", head)
+	}
+
+	git("remote", "add", "fork", "git@github.com:octocat/repo.git")
+	git("config", "branch.local-fix.remote", "fork")
+	git("config", "branch.local-fix.merge", "refs/heads/fix")
+
+	head, err = currentBranchHead(dir)
+	if err != nil {
+		t.Fatalf("currentBranchHead() unexpected error: %v", err)
+	}
+	if want := (api.BranchHead{Owner: "octocat", Branch: "fix"}); head != want {
+		t.Errorf("currentBranchHead() = %+v, want %+v", head, want)
+	}
+}
+
+func TestPRArgumentIsOptional(t *testing.T) {
+	for _, cmd := range []*cobra.Command{
+		addCmd, applyCmd, commentsCmd, deleteCmd, discardCmd, editCmd,
+		replyCmd, resolveCmd, submitCmd, unresolveCmd, viewCmd,
+	} {
+		if err := cmd.Args(cmd, nil); err != nil {
+			t.Errorf("%s: no arguments rejected: %v", cmd.Name(), err)
+		}
+		if err := cmd.Args(cmd, []string{"1", "2"}); err == nil {
+			t.Errorf("%s: two arguments accepted", cmd.Name())
+		}
+	}
+
+	if err := importCmd.Args(importCmd, []string{"report.sarif"}); err != nil {
+		t.Errorf("import: report without PR rejected: %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment [<number>]",
+	Short: "Comment on a PR's conversation",
+	Long: `Post a top-level discussion comment on a pull request.
+
+Unlike 'add', the comment is not attached to code and is posted at once
+rather than added to your pending review. Edit or delete it later with
+'edit' and 'delete', passing its IC_… node ID.`,
+	Example: `  gh review comment 123 -b "Rebased onto main, ready for another look"
+  gh review comment -e`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runComment,
+}
+
+var (
+	discussionBody   string
+	discussionEditor bool
+)
+
+func init() {
+	rootCmd.AddCommand(commentCmd)
+	commentCmd.Flags().StringVarP(&discussionBody, "body", "b", "", "Comment body (required unless using --editor)")
+	commentCmd.Flags().BoolVarP(&discussionEditor, "editor", "e", false, "Write the comment in your editor")
+}
+
+func runComment(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args)
+	if err != nil {
+		return err
+	}
+
+	if discussionBody == "" && !discussionEditor {
+		return fmt.Errorf("--body is required (or use --editor)")
+	}
+
+	body := discussionBody
+	if discussionEditor {
+		if body, err = composeBody(body, []string{fmt.Sprintf("Comment on the conversation of %s", pr)}); err != nil {
+			return err
+		}
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	identity, err := client.ResolvePR(pr)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.AddComment(api.AddCommentInput{
+		PRNodeID: identity.NodeID,
+		Body:     body,
+	})
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(output.CommentResult{
+		PRRef:     pr.String(),
+		CommentID: result.ID,
+		URL:       result.URL,
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/srnnkls/gh-review/internal/api"
@@ -12,16 +13,42 @@ import (
 )
 
 var commentsCmd = &cobra.Command{
-	Use:   "comments <number>",
+	Use:   "comments [<number>]",
 	Short: "List PR comments",
 	Long: `List all comments for a pull request.
 
-Shows review comments grouped by author. Use flags to filter and control output.`,
+Shows review comments grouped by author. Use flags to filter and control output.
+Review comments are labelled with a short reference (c3.2: thread 3, comment
+2) that --comment accepts in other commands. Replies are marked with ↳; list
+the replies drafted with "reply --draft" using --mine --states=pending.
+Comments hidden with 'hide' are labelled with the reason they were hidden;
+--exclude-hidden leaves them out. Reaction counts are starred when they
+include yours; --unacknowledged keeps the comments the PR author has neither
+replied to nor reacted to. --awaiting-me and --awaiting-others keep the
+comments of unresolved threads waiting on your reply or on others' after
+yours; see 'view'. JSON output reports each review comment's
+thread_last_activity.
+
+--new and --since-last keep the comments made since the PR was marked read;
+see 'view' and 'mark-read'. --since-last cannot be combined with the flags
+that leave comments out, as it would mark those read too. --since and --until keep the comments made within
+a time range, given as RFC3339 (2026-03-01T09:00:00Z), a date (2026-03-01)
+or an age before now (90m, 12h, 2d, 1w); a date given to --until takes in
+that whole day. Comments are listed in the order GitHub returns them, by
+review; --chronological orders them by creation time instead, so --tail
+keeps the most recent. Each comment shows when it was created; JSON output
+also reports updated_at and published_at.`,
 	Example: `  gh review comments 123
   gh review comments 123 --mine --states=pending --ids
   gh review comments 123 --states=changes_requested --tail=10
-  gh review comments 123 --author=octocat`,
-	Args: cobra.ExactArgs(1),
+  gh review comments 123 --author=octocat
+  gh review comments 123 --exclude-hidden
+  gh review comments 123 --unacknowledged
+  gh review comments 123 --awaiting-me --flat
+  gh review comments 123 --new
+  gh review comments 123 --since 2d --chronological --flat
+  gh review comments 123 --since 2026-03-01 --until 2026-03-08`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runComments,
 }
 
@@ -34,6 +61,12 @@ var (
 	listIDs        bool
 	listFlat       bool
 	listLimit      int
+	listNoHidden   bool
+	listUnacked    bool
+	listAwaiting   awaitingFilter
+	listRead       readFilter
+	listTime       timeFilter
+	listChrono     bool
 )
 
 func init() {
@@ -42,14 +75,25 @@ func init() {
 	commentsCmd.Flags().StringVarP(&listAuthor, "author", "a", "", "Filter by author username")
 	commentsCmd.Flags().BoolVar(&listMine, "mine", false, "Show only my comments (current authenticated user)")
 	commentsCmd.Flags().BoolVar(&listUnresolved, "unresolved", false, "Show only unresolved review threads")
-	commentsCmd.Flags().IntVar(&listTail, "tail", 0, "Return last N comments (most recent first)")
+	commentsCmd.Flags().IntVar(&listTail, "tail", 0, "Return the last N comments")
 	commentsCmd.Flags().BoolVar(&listIDs, "ids", false, "Include comment IDs in output")
 	commentsCmd.Flags().BoolVar(&listFlat, "flat", false, "Disable author grouping (flat list)")
-	commentsCmd.Flags().IntVar(&listLimit, "limit", 100, "Maximum comments to fetch")
+	commentsCmd.Flags().IntVar(&listLimit, "limit", 100, "Maximum comments to fetch (0 for no limit)")
+	commentsCmd.Flags().BoolVar(&listNoHidden, "exclude-hidden", false, "Leave out hidden (minimized) comments")
+	commentsCmd.Flags().BoolVar(&listUnacked, "unacknowledged", false, "Show only comments the PR author has not replied or reacted to")
+	addAwaitingFlags(commentsCmd, &listAwaiting)
+	addTimeFlags(commentsCmd, &listTime)
+	addReadFlags(commentsCmd, &listRead, "states", "author", "mine", "unresolved", "tail", "exclude-hidden",
+		"unacknowledged", "awaiting-me", "awaiting-others", "since", "until")
+	commentsCmd.Flags().BoolVar(&listChrono, "chronological", false, "Sort comments by creation time, oldest first")
 }
 
 func runComments(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
+	if err := listTime.prepare(time.Now()); err != nil {
+		return err
+	}
+
+	pr, err := resolvePRArgs(args)
 	if err != nil {
 		return err
 	}
@@ -68,6 +112,19 @@ func runComments(cmd *cobra.Command, args []string) error {
 		listAuthor = login
 	}
 
+	var author string
+	if listUnacked {
+		if author, err = prAuthor(client, pr); err != nil {
+			return err
+		}
+	}
+	if err := listAwaiting.prepare(client, pr); err != nil {
+		return err
+	}
+	if err := listRead.prepare(pr); err != nil {
+		return err
+	}
+
 	var comments []*output.Comment
 	var truncated bool
 
@@ -81,16 +138,44 @@ func runComments(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		truncated = threads.Truncated
+		if listUnacked {
+			if err := completeReactions(client, threads.Threads, nil, author); err != nil {
+				return err
+			}
+		}
 
 		for _, thread := range threads.Threads {
-			for _, c := range thread.Comments {
+			if listUnacked && threadAcknowledged(thread, author) {
+				continue
+			}
+			if !listAwaiting.matches(thread) {
+				continue
+			}
+			for j, c := range thread.Comments {
+				if !listRead.unread(c.CreatedAt) || !listTime.matches(c.CreatedAt) {
+					continue
+				}
 				cmt := &output.Comment{
-					ID:     c.ID,
-					Path:   thread.Path,
-					Line:   thread.Line,
-					Body:   c.Body,
-					State:  "unresolved",
-					Author: c.Author,
+					ID:        c.ID,
+					Ref:       api.CommentRef(thread.Number, j+1),
+					Path:      thread.Path,
+					Line:      thread.Line,
+					Body:      c.Body,
+					State:     "unresolved",
+					Author:    c.Author,
+					FileLevel: thread.IsFileLevel,
+
+					Minimized:       c.IsMinimized,
+					MinimizedReason: c.MinimizedReason,
+					Reactions:       outputReactions(c.Reactions),
+					LastActivity:    thread.LastActivity(),
+
+					CreatedAt:   c.CreatedAt,
+					UpdatedAt:   c.UpdatedAt,
+					PublishedAt: c.PublishedAt,
+				}
+				if j > 0 {
+					cmt.ReplyTo = api.CommentRef(thread.Number, 1)
 				}
 				if matchesFilters(cmt) {
 					comments = append(comments, cmt)
@@ -108,14 +193,57 @@ func runComments(cmd *cobra.Command, args []string) error {
 		}
 		truncated = allComments.Truncated
 
+		// Threads give the comments their refs and back the thread-based
+		// filters. Comments come in review order and may belong to any
+		// thread, so every thread is fetched.
+		threads, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+		if err != nil {
+			return err
+		}
+		refs := commentRefs(threads.Threads)
+		threadOf := commentThreads(threads.Threads)
+		var acked map[string]bool
+		if listUnacked {
+			if err := completeReactions(client, threads.Threads, allComments.PRComments, author); err != nil {
+				return err
+			}
+			acked = acknowledgedComments(threads.Threads, author)
+		}
+
 		for _, c := range allComments.ReviewComments {
+			if acked[c.ID] || !listRead.unread(c.CreatedAt) || !listTime.matches(c.CreatedAt) {
+				continue
+			}
+			thread := threadOf[c.ID]
+			if listAwaiting.active() && (thread == nil || !listAwaiting.matches(thread)) {
+				continue
+			}
 			cmt := &output.Comment{
-				ID:     c.ID,
-				Path:   c.Path,
-				Line:   c.Line,
-				Body:   c.Body,
-				State:  c.State,
-				Author: c.Author,
+				ID:        c.ID,
+				Ref:       refs[c.ID],
+				Path:      c.Path,
+				Line:      c.Line,
+				Body:      c.Body,
+				State:     c.State,
+				Author:    c.Author,
+				FileLevel: c.FileLevel,
+
+				Minimized:       c.IsMinimized,
+				MinimizedReason: c.MinimizedReason,
+				Reactions:       outputReactions(c.Reactions),
+
+				CreatedAt:   c.CreatedAt,
+				UpdatedAt:   c.UpdatedAt,
+				PublishedAt: c.PublishedAt,
+			}
+			if thread != nil {
+				cmt.LastActivity = thread.LastActivity()
+			}
+			if c.ReplyToID != "" {
+				cmt.ReplyTo = refs[c.ReplyToID]
+				if cmt.ReplyTo == "" {
+					cmt.ReplyTo = c.ReplyToID
+				}
 			}
 			if matchesFilters(cmt) {
 				comments = append(comments, cmt)
@@ -123,11 +251,27 @@ func runComments(cmd *cobra.Command, args []string) error {
 		}
 
 		for _, c := range allComments.PRComments {
+			// Discussion comments belong to no thread to await a reply in.
+			if listAwaiting.active() {
+				break
+			}
+			if listUnacked && (strings.EqualFold(c.Author, author) || api.ReactedBy(c.Reactions, author)) {
+				continue
+			}
+			if !listRead.unread(c.CreatedAt) || !listTime.matches(c.CreatedAt) {
+				continue
+			}
 			cmt := &output.Comment{
 				ID:     c.ID,
 				Body:   c.Body,
 				State:  "discussion",
 				Author: c.Author,
+
+				Minimized:       c.IsMinimized,
+				MinimizedReason: c.MinimizedReason,
+				Reactions:       outputReactions(c.Reactions),
+
+				CreatedAt: c.CreatedAt,
 			}
 			if matchesFilters(cmt) {
 				comments = append(comments, cmt)
@@ -135,6 +279,10 @@ func runComments(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if listChrono {
+		sortChronologically(comments)
+	}
+
 	// Apply --tail limit
 	if listTail > 0 && len(comments) > listTail {
 		comments = comments[len(comments)-listTail:]
@@ -177,14 +325,65 @@ func runComments(cmd *cobra.Command, args []string) error {
 	if err := formatter.Format(result); err != nil {
 		return err
 	}
+	for _, c := range comments {
+		listRead.shown(c.ID, c.State, c.CreatedAt)
+	}
+	if err := listRead.finish(truncated); err != nil {
+		return err
+	}
 
 	if truncated {
-		fmt.Fprintf(os.Stderr, "Warning: results may be truncated. Use --limit to fetch more.\n")
+		fmt.Fprintf(os.Stderr, "Warning: results truncated at --limit=%d. Use --limit=0 to fetch all.\n", listLimit)
 	}
 
 	return nil
 }
 
+// commentRefs maps comment node IDs to their short references.
+func commentRefs(threads []*api.Thread) map[string]string {
+	refs := make(map[string]string)
+	for _, t := range threads {
+		for j, c := range t.Comments {
+			refs[c.ID] = api.CommentRef(t.Number, j+1)
+		}
+	}
+	return refs
+}
+
+// commentThreads maps comment node IDs to the threads they belong to.
+func commentThreads(threads []*api.Thread) map[string]*api.Thread {
+	byComment := make(map[string]*api.Thread)
+	for _, t := range threads {
+		for _, c := range t.Comments {
+			byComment[c.ID] = t
+		}
+	}
+	return byComment
+}
+
+// acknowledgedComments marks the comments of threads login took part in;
+// see threadAcknowledged.
+func acknowledgedComments(threads []*api.Thread, login string) map[string]bool {
+	acked := make(map[string]bool)
+	for _, t := range threads {
+		if !threadAcknowledged(t, login) {
+			continue
+		}
+		for _, c := range t.Comments {
+			acked[c.ID] = true
+		}
+	}
+	return acked
+}
+
+// sortChronologically orders comments by creation time, oldest first,
+// keeping the order of comments created at the same time.
+func sortChronologically(comments []*output.Comment) {
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+}
+
 func matchesFilters(c *output.Comment) bool {
 	if len(listStates) > 0 {
 		matched := false
@@ -201,5 +400,8 @@ func matchesFilters(c *output.Comment) bool {
 	if listAuthor != "" && !strings.EqualFold(c.Author, listAuthor) {
 		return false
 	}
+	if listNoHidden && c.Minimized {
+		return false
+	}
 	return true
 }
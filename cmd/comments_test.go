@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/srnnkls/gh-review/internal/output"
 )
@@ -212,3 +214,23 @@ func TestMatchesFiltersMultipleStatesOrLogic(t *testing.T) {
 		}
 	}
 }
+
+func TestSortChronologically(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 3, 1, 9, min, 0, 0, time.UTC) }
+	comments := []*output.Comment{
+		{ID: "PRRC_2", CreatedAt: at(20)},
+		{ID: "PRRC_1", CreatedAt: at(5)},
+		{ID: "IC_1", CreatedAt: at(10)},
+		{ID: "IC_2", CreatedAt: at(10)},
+	}
+
+	sortChronologically(comments)
+
+	var got []string
+	for _, c := range comments {
+		got = append(got, c.ID)
+	}
+	if want := "PRRC_1 IC_1 IC_2 PRRC_2"; strings.Join(got, " ") != want {
+		t.Errorf("order = %v, want %s", got, want)
+	}
+}
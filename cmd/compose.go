@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/diff"
+	"github.com/srnnkls/gh-review/internal/editor"
+)
+
+// editorContextLines is how many diff lines around the commented lines the
+// editor scaffold shows.
+const editorContextLines = 3
+
+// composeBody opens the editor on initial, with context commented out below
+// it, and returns the saved body.
+func composeBody(initial string, context []string) (string, error) {
+	body, err := editor.Edit(initial, context)
+	if errors.Is(err, editor.ErrEmpty) {
+		return "", fmt.Errorf("aborted: empty body")
+	}
+	return body, err
+}
+
+// anchorContext describes where a new comment will go, with the diff lines
+// it covers when the diff is known.
+func anchorContext(prDiff *diff.Diff, input *api.AddThreadInput) []string {
+	anchor := anchorFor(input)
+	if anchor.FileLevel {
+		return []string{fmt.Sprintf("Comment on %s (whole file)", anchor.Path)}
+	}
+	side, err := diff.ParseSide(anchor.Side)
+	if err != nil {
+		side = diff.Right
+	}
+
+	context := []string{fmt.Sprintf("Comment on %s:%s (%s)", anchor.Path, diff.LineRange(anchor.StartLine, anchor.Line), side)}
+	if prDiff == nil {
+		return context
+	}
+	if f := prDiff.File(anchor.Path); f != nil {
+		if excerpt := f.Excerpt(side, anchor.StartLine, anchor.Line, editorContextLines); excerpt != nil {
+			context = append(context, "")
+			context = append(context, excerpt...)
+		}
+	}
+	return context
+}
+
+// threadContext shows the code a thread is attached to and its conversation
+// so far.
+func threadContext(t *api.Thread) []string {
+	at := t.Path + ":" + diff.LineRange(t.StartLine, t.Line)
+	if t.IsFileLevel {
+		at = t.Path + " (whole file)"
+	}
+	context := []string{"Reply on " + at}
+	if hunk := diff.HunkTail(t.DiffHunk, 2*editorContextLines+1); len(hunk) > 0 {
+		context = append(context, "")
+		context = append(context, hunk...)
+	}
+	for _, c := range t.Comments {
+		context = append(context, "", "@"+c.Author+":")
+		for _, line := range strings.Split(strings.TrimSpace(c.Body), "\n") {
+			context = append(context, "  "+line)
+		}
+	}
+	return context
+}
+
+// commentContext shows the code an existing review comment is attached to.
+func commentContext(c *api.ReviewComment) []string {
+	context := []string{"Editing comment on " + reviewCommentLocation(c)}
+	if hunk := diff.HunkTail(c.DiffHunk, 2*editorContextLines+1); len(hunk) > 0 {
+		context = append(context, "")
+		context = append(context, hunk...)
+	}
+	return context
+}
+
+// discussionContext identifies the PR discussion comment being edited.
+func discussionContext(c *api.PRComment) []string {
+	return []string{fmt.Sprintf("Editing PR comment by @%s", c.Author)}
+}
+
+// reviewContext lists the draft comments a review body will be submitted
+// with.
+func reviewContext(pr *api.PRRef, event string, review *api.PendingReview) []string {
+	context := []string{fmt.Sprintf("Review summary for %s (%s)", pr, strings.ToLower(event))}
+	if review == nil || len(review.Comments) == 0 {
+		return context
+	}
+
+	context = append(context, "", fmt.Sprintf("%d draft %s:", len(review.Comments), pluralize(len(review.Comments), "comment", "comments")))
+	for _, c := range review.Comments {
+		first, _, _ := strings.Cut(strings.TrimSpace(c.Body), "\n")
+		if c.ReplyToID != "" {
+			first = "↳ " + first
+		}
+		context = append(context, fmt.Sprintf("  %s  %s", reviewCommentLocation(c), first))
+	}
+	return context
+}
+
+// reviewCommentLocation renders the path and lines a review comment is
+// attached to; file-level comments, which have no line, show the path alone.
+func reviewCommentLocation(c *api.ReviewComment) string {
+	if c.FileLevel || c.Line == 0 {
+		return c.Path + " (whole file)"
+	}
+	start := 0
+	if c.StartLine != nil {
+		start = *c.StartLine
+	}
+	return c.Path + ":" + diff.LineRange(start, c.Line)
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func TestThreadContext(t *testing.T) {
+	thread := &api.Thread{
+		Path:     "main.go",
+		Line:     4,
+		DiffHunk: "@@ -1,2 +1,4 @@\n a\n+b",
+		Comments: []*api.ThreadComment{{Author: "octocat", Body: "Why?\nPlease explain."}},
+	}
+
+	got := strings.Join(threadContext(thread), "\n")
+	for _, want := range []string{"Reply on main.go:4", "+b", "@octocat:", "  Please explain."} {
+		if !strings.Contains(got, want) {
+			t.Errorf("threadContext() = %q, want containing %q", got, want)
+		}
+	}
+}
+
+func TestReviewContext(t *testing.T) {
+	pr := &api.PRRef{Owner: "o", Repo: "r", Number: 1}
+	review := &api.PendingReview{Comments: []*api.ReviewComment{
+		{Path: "a.go", Line: 3, Body: "First line\nmore"},
+		{Path: "b.go", Line: 7, Body: "Agreed", ReplyToID: "PRRC_1"},
+		{Path: "c.go", FileLevel: true, Body: "Split this file"},
+	}}
+
+	got := strings.Join(reviewContext(pr, "REQUEST_CHANGES", review), "\n")
+	for _, want := range []string{"(request_changes)", "3 draft comments:", "a.go:3  First line", "b.go:7  ↳ Agreed", "c.go (whole file)  Split"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("reviewContext() = %q, want containing %q", got, want)
+		}
+	}
+	if strings.Contains(got, "more") {
+		t.Errorf("reviewContext() = %q, want only the first body line", got)
+	}
+}
+
+func TestCommentContext(t *testing.T) {
+	start := 18
+	tests := []struct {
+		name    string
+		comment *api.ReviewComment
+		want    string
+	}{
+		{"line", &api.ReviewComment{Path: "main.go", Line: 20}, "Editing comment on main.go:20"},
+		{"range", &api.ReviewComment{Path: "main.go", StartLine: &start, Line: 20}, "Editing comment on main.go:18-20"},
+		{"file level", &api.ReviewComment{Path: "main.go", FileLevel: true}, "Editing comment on main.go (whole file)"},
+		{"no line", &api.ReviewComment{Path: "main.go"}, "Editing comment on main.go (whole file)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commentContext(tt.comment)[0]; got != tt.want {
+				t.Errorf("commentContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
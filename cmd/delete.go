@@ -9,12 +9,14 @@ import (
 )
 
 var deleteCmd = &cobra.Command{
-	Use:   "delete <number>",
-	Short: "Delete a draft comment",
-	Long:  `Delete a comment from your pending review.`,
+	Use:   "delete [<number>]",
+	Short: "Delete a comment",
+	Long: `Delete a review comment, such as one from your pending review, or a PR
+discussion comment (IC_… node ID).`,
 	Example: `  gh review delete 123 -c PRRC_xxx
-  gh review delete 123 -R owner/repo -c PRRC_xxx`,
-	Args: cobra.ExactArgs(1),
+  gh review delete 123 -R owner/repo -c PRRC_xxx
+  gh review delete -c IC_xxx`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runDelete,
 }
 
@@ -22,29 +24,41 @@ var deleteCommentID string
 
 func init() {
 	rootCmd.AddCommand(deleteCmd)
-	deleteCmd.Flags().StringVarP(&deleteCommentID, "comment", "c", "", "Comment ID (GraphQL node ID, required)")
+	deleteCmd.Flags().StringVarP(&deleteCommentID, "comment", "c", "", "Comment to act on: c3.2, comment ID, URL or node ID (required)")
 
 	deleteCmd.MarkFlagRequired("comment")
 }
 
 func runDelete(cmd *cobra.Command, args []string) error {
-	_, err := resolvePR(args[0])
+	// Comment node IDs are global, so the PR is only checked when one is
+	// given or needed to look up a short reference.
+	if len(args) > 0 {
+		if _, err := resolvePR(args[0]); err != nil {
+			return err
+		}
+	}
+
+	client, err := api.NewClient()
 	if err != nil {
 		return err
 	}
 
-	client, err := api.NewClient()
+	commentID, err := resolveCommentArg(client, args, deleteCommentID)
 	if err != nil {
 		return err
 	}
 
-	err = client.DeleteComment(deleteCommentID)
+	if api.IsIssueCommentID(commentID) {
+		err = client.DeleteIssueComment(commentID)
+	} else {
+		err = client.DeleteComment(commentID)
+	}
 	if err != nil {
 		return err
 	}
 
 	result := output.DeleteResult{
-		CommentID: deleteCommentID,
+		CommentID: commentID,
 	}
 
 	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
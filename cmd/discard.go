@@ -9,14 +9,14 @@ import (
 )
 
 var discardCmd = &cobra.Command{
-	Use:   "discard <number>",
+	Use:   "discard [<number>]",
 	Short: "Discard a pending review",
 	Long: `Discard your pending review and all its comments.
 
 This action cannot be undone.`,
 	Example: `  gh review discard 123
   gh review discard 123 -R owner/repo`,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.MaximumNArgs(1),
 	RunE: runDiscard,
 }
 
@@ -28,7 +28,7 @@ func init() {
 }
 
 func runDiscard(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
+	pr, err := resolvePRArgs(args)
 	if err != nil {
 		return err
 	}
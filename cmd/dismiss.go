@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var dismissCmd = &cobra.Command{
+	Use:   "dismiss [<number>]",
+	Short: "Dismiss a submitted review",
+	Long: `Dismiss an approval or change request, for example one made stale by new
+commits. The message is posted on the PR as the reason and is required.
+
+Name the review by its node ID (--review; see 'reviews --ids'), or dismiss
+a reviewer's effective review with --reviewer.`,
+	Example: `  gh review dismiss 123 --reviewer octocat -m "Addressed in abc1234"
+  gh review dismiss 123 --review PRR_xxx -m "Requirements changed"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runDismiss,
+}
+
+var (
+	dismissReviewID string
+	dismissReviewer string
+	dismissMessage  string
+)
+
+func init() {
+	rootCmd.AddCommand(dismissCmd)
+	dismissCmd.Flags().StringVar(&dismissReviewID, "review", "", "Review to dismiss (GraphQL node ID)")
+	dismissCmd.Flags().StringVar(&dismissReviewer, "reviewer", "", "Dismiss this user's approval or change request")
+	dismissCmd.Flags().StringVarP(&dismissMessage, "message", "m", "", "Reason for dismissing the review (required)")
+
+	dismissCmd.MarkFlagRequired("message")
+	dismissCmd.MarkFlagsMutuallyExclusive("review", "reviewer")
+	dismissCmd.MarkFlagsOneRequired("review", "reviewer")
+}
+
+func runDismiss(cmd *cobra.Command, args []string) error {
+	if strings.TrimSpace(dismissMessage) == "" {
+		return fmt.Errorf("--message must not be empty")
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	reviewID, author := dismissReviewID, ""
+	if dismissReviewer != "" {
+		pr, err := resolvePRArgs(args)
+		if err != nil {
+			return err
+		}
+		reviews, err := client.Reviews(pr)
+		if err != nil {
+			return err
+		}
+		review, err := dismissableReview(api.LatestReviews(reviews), dismissReviewer)
+		if err != nil {
+			return err
+		}
+		reviewID, author = review.ID, review.Author
+	}
+
+	result, err := client.DismissReview(reviewID, dismissMessage)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(output.DismissResult{
+		ReviewID: result.ID,
+		Author:   author,
+		State:    result.State,
+	})
+}
+
+// dismissableReview picks the reviewer's effective review from latest,
+// which only approvals and change requests can be.
+func dismissableReview(latest []*api.Review, reviewer string) (*api.Review, error) {
+	reviewer = strings.TrimPrefix(strings.TrimSpace(reviewer), "@")
+	for _, r := range latest {
+		if !strings.EqualFold(r.Author, reviewer) {
+			continue
+		}
+		if r.State != "approved" && r.State != "changes_requested" {
+			return nil, fmt.Errorf("@%s has no approval or change request to dismiss (latest review: %s)", r.Author, r.State)
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("@%s has not reviewed this PR", reviewer)
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -9,50 +10,103 @@ import (
 )
 
 var editCmd = &cobra.Command{
-	Use:   "edit <number>",
-	Short: "Edit a draft comment",
-	Long:  `Edit an existing comment in your pending review.`,
+	Use:   "edit [<number>]",
+	Short: "Edit a comment",
+	Long: `Edit an existing review comment, such as one in your pending review, or a
+PR discussion comment (IC_… node ID).
+
+With --editor, the current body is opened in your editor with the commented
+code shown below for reference.`,
 	Example: `  gh review edit 123 -c PRRC_xxx -b "Updated comment body"
-  gh review edit 123 -R owner/repo -c PRRC_xxx -b "Updated"`,
-	Args: cobra.ExactArgs(1),
+  gh review edit 123 -R owner/repo -c PRRC_xxx -b "Updated"
+  gh review edit 123 -c PRRC_xxx -e
+  gh review edit -c IC_xxx -b "Updated discussion comment"`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runEdit,
 }
 
 var (
 	editCommentID string
 	editBody      string
+	editEditor    bool
 )
 
 func init() {
 	rootCmd.AddCommand(editCmd)
-	editCmd.Flags().StringVarP(&editCommentID, "comment", "c", "", "Comment ID (GraphQL node ID, required)")
-	editCmd.Flags().StringVarP(&editBody, "body", "b", "", "New comment body (required)")
+	editCmd.Flags().StringVarP(&editCommentID, "comment", "c", "", "Comment to act on: c3.2, comment ID, URL or node ID (required)")
+	editCmd.Flags().StringVarP(&editBody, "body", "b", "", "New comment body (required unless using --editor)")
+	editCmd.Flags().BoolVarP(&editEditor, "editor", "e", false, "Edit the current comment body in your editor")
 
 	editCmd.MarkFlagRequired("comment")
-	editCmd.MarkFlagRequired("body")
 }
 
 func runEdit(cmd *cobra.Command, args []string) error {
-	_, err := resolvePR(args[0])
+	// Comment node IDs are global, so the PR is only checked when one is
+	// given or needed to look up a short reference.
+	if len(args) > 0 {
+		if _, err := resolvePR(args[0]); err != nil {
+			return err
+		}
+	}
+
+	if editBody == "" && !editEditor {
+		return fmt.Errorf("--body is required (or use --editor)")
+	}
+
+	client, err := api.NewClient()
 	if err != nil {
 		return err
 	}
 
-	client, err := api.NewClient()
+	commentID, err := resolveCommentArg(client, args, editCommentID)
 	if err != nil {
 		return err
 	}
 
-	err = client.UpdateComment(api.UpdateCommentInput{
-		CommentID: editCommentID,
-		Body:      editBody,
-	})
+	discussion := api.IsIssueCommentID(commentID)
+
+	body := editBody
+	if editEditor {
+		var (
+			current string
+			context []string
+		)
+		if discussion {
+			comment, err := client.PRCommentByID(commentID)
+			if err != nil {
+				return err
+			}
+			current, context = comment.Body, discussionContext(comment)
+		} else {
+			comment, err := client.ReviewCommentByID(commentID)
+			if err != nil {
+				return err
+			}
+			current, context = comment.Body, commentContext(comment)
+		}
+		if body == "" {
+			body = current
+		}
+		if body, err = composeBody(body, context); err != nil {
+			return err
+		}
+	}
+
+	input := api.UpdateCommentInput{
+		CommentID: commentID,
+		Body:      body,
+	}
+	if discussion {
+		err = client.UpdateIssueComment(input)
+	} else {
+		err = client.UpdateComment(input)
+	}
 	if err != nil {
 		return err
 	}
 
 	result := output.EditResult{
-		CommentID: editCommentID,
+		CommentID: commentID,
 	}
 
 	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
@@ -0,0 +1,358 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+// hideWorkers bounds concurrent minimize/unminimize mutations.
+const hideWorkers = 4
+
+var hideCmd = &cobra.Command{
+	Use:   "hide [<number>]",
+	Short: "Hide (minimize) comments",
+	Long: `Hide review comments and PR discussion comments.
+
+Hidden comments are collapsed on GitHub behind the reason they were hidden
+for: outdated, resolved, off-topic, duplicate, spam or abuse. 'view' and
+'comments' label them, and leave them out with --exclude-hidden.
+
+Name comments with --comment, which may be repeated or take a
+comma-separated list. Besides node IDs (PRRC_… for review comments, IC_…
+for discussion comments), it accepts the short references printed by 'view'
+and 'comments' (c3.2), review comment database IDs and comment URLs.
+
+Alternatively, select shown comments with filters. Matching comments are
+listed for confirmation before anything is hidden; pass --yes to skip the
+prompt, which is required when stdin is not a terminal. --outdated and
+--resolved imply the reason of the same name unless --reason is given.`,
+	Example: `  gh review hide 123 -c c3.2 --reason off-topic
+  gh review hide 123 -c IC_xxx,IC_yyy --reason duplicate
+  gh review hide 123 --outdated
+  gh review hide 123 --resolved --author octocat --yes
+  gh review hide 123 --discussion --author dependabot --reason spam`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runHide,
+}
+
+var (
+	hideComments []string
+	hideReason   string
+	hideFilter   commentFilter
+	hideYes      bool
+)
+
+func init() {
+	rootCmd.AddCommand(hideCmd)
+	hideCmd.Flags().StringSliceVarP(&hideComments, "comment", "c", nil, "Comments to hide: c3.2, comment ID, URL or node ID")
+	hideCmd.Flags().StringVar(&hideReason, "reason", "", fmt.Sprintf("Why the comments are hidden: %s", strings.Join(api.MinimizeReasons, ", ")))
+	addCommentFilterFlags(hideCmd, &hideFilter, "shown")
+	hideCmd.Flags().BoolVarP(&hideYes, "yes", "y", false, "Hide filtered comments without asking")
+
+	for _, filter := range commentFilterFlags {
+		hideCmd.MarkFlagsMutuallyExclusive("comment", filter)
+	}
+}
+
+func runHide(cmd *cobra.Command, args []string) error {
+	reason := hideReason
+	if reason == "" {
+		reason = hideFilter.impliedReason()
+	}
+	if reason == "" {
+		return fmt.Errorf("--reason is required: one of %s", strings.Join(api.MinimizeReasons, ", "))
+	}
+	if _, err := api.ParseMinimizeReason(reason); err != nil {
+		return err
+	}
+
+	pr, err := resolvePRArgs(args, hideComments...)
+	if err != nil {
+		return err
+	}
+
+	return runCommentVisibilityChange(cmd, pr, true, reason, hideComments, &hideFilter, hideYes)
+}
+
+// commentFilter selects review and PR discussion comments for hide and
+// unhide. Empty fields match every comment; All selects every comment
+// without any criteria. Path, Outdated and Resolved only match review
+// comments, whose thread they describe.
+type commentFilter struct {
+	Author     string
+	Path       string
+	Outdated   bool
+	Resolved   bool
+	Discussion bool
+	All        bool
+}
+
+// commentFilterFlags lists the flags registered by addCommentFilterFlags, so
+// commands can mark them exclusive with explicit IDs.
+var commentFilterFlags = []string{"author", "path", "outdated", "resolved", "discussion", "all"}
+
+func addCommentFilterFlags(cmd *cobra.Command, f *commentFilter, state string) {
+	cmd.Flags().StringVar(&f.Author, "author", "", fmt.Sprintf("Select %s comments written by this user", state))
+	cmd.Flags().StringVar(&f.Path, "path", "", fmt.Sprintf("Select %s review comments on files matching this glob", state))
+	cmd.Flags().BoolVar(&f.Outdated, "outdated", false, "Select review comments in outdated threads")
+	cmd.Flags().BoolVar(&f.Resolved, "resolved", false, "Select review comments in resolved threads")
+	cmd.Flags().BoolVar(&f.Discussion, "discussion", false, "Select only PR discussion comments")
+	cmd.Flags().BoolVar(&f.All, "all", false, fmt.Sprintf("Select every %s comment", state))
+
+	for _, review := range []string{"path", "outdated", "resolved"} {
+		cmd.MarkFlagsMutuallyExclusive("discussion", review)
+	}
+}
+
+// selecting reports whether any filter flag was given.
+func (f commentFilter) selecting() bool {
+	return f.All || f.Author != "" || f.Path != "" || f.Outdated || f.Resolved || f.Discussion
+}
+
+// impliedReason is the reason the filter selects comments for, if any.
+func (f commentFilter) impliedReason() string {
+	switch {
+	case f.Outdated:
+		return "outdated"
+	case f.Resolved:
+		return "resolved"
+	default:
+		return ""
+	}
+}
+
+// commentCandidate is a comment a commentFilter can select: a review
+// comment, described by its thread, or a PR discussion comment.
+type commentCandidate struct {
+	Item       output.HideItem
+	Discussion bool
+	Outdated   bool
+	Resolved   bool
+	Minimized  bool
+}
+
+// matches reports whether c satisfies every set criterion. Author is the
+// login that wrote the comment; Path is matched as for threadFilter.
+func (f commentFilter) matches(c commentCandidate) bool {
+	if f.Author != "" && !strings.EqualFold(c.Item.Author, strings.TrimPrefix(f.Author, "@")) {
+		return false
+	}
+	if f.Path != "" && (c.Discussion || !matchPathGlob(f.Path, c.Item.Path)) {
+		return false
+	}
+	if f.Outdated && !c.Outdated {
+		return false
+	}
+	if f.Resolved && !c.Resolved {
+		return false
+	}
+	if f.Discussion && !c.Discussion {
+		return false
+	}
+	return true
+}
+
+// commentCandidates lists the comments of threads, then the PR's discussion
+// comments.
+func commentCandidates(threads []*api.Thread, discussion []*api.PRComment) []commentCandidate {
+	var candidates []commentCandidate
+	for _, t := range threads {
+		for j, c := range t.Comments {
+			candidates = append(candidates, commentCandidate{
+				Item: output.HideItem{
+					CommentID: c.ID,
+					Ref:       api.CommentRef(t.Number, j+1),
+					Author:    c.Author,
+					Path:      t.Path,
+					Line:      t.Line,
+				},
+				Outdated:  t.IsOutdated,
+				Resolved:  t.IsResolved,
+				Minimized: c.IsMinimized,
+			})
+		}
+	}
+	for _, c := range discussion {
+		candidates = append(candidates, commentCandidate{
+			Item: output.HideItem{
+				CommentID: c.ID,
+				Author:    c.Author,
+			},
+			Discussion: true,
+			Minimized:  c.IsMinimized,
+		})
+	}
+	return candidates
+}
+
+// filterComments returns the candidates in the given visibility that match f.
+func filterComments(candidates []commentCandidate, minimized bool, f commentFilter) []commentCandidate {
+	var matched []commentCandidate
+	for _, c := range candidates {
+		if c.Minimized == minimized && f.matches(c) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// selectComments fetches the comments f can match and keeps those in the
+// given visibility that do.
+func selectComments(client *api.Client, pr *api.PRRef, minimized bool, f commentFilter) ([]commentCandidate, error) {
+	var threads []*api.Thread
+	if !f.Discussion {
+		result, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+		if err != nil {
+			return nil, err
+		}
+		threads = result.Threads
+	}
+
+	var discussion []*api.PRComment
+	if f.Path == "" && !f.Outdated && !f.Resolved {
+		var err error
+		discussion, _, err = client.PRComments(pr, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return filterComments(commentCandidates(threads, discussion), minimized, f), nil
+}
+
+// confirmComments previews the selected comments on stderr and asks before
+// acting on them.
+func confirmComments(verb string, comments []commentCandidate, yes bool) error {
+	fmt.Fprintf(os.Stderr, "Will %s %d %s:\n", verb, len(comments), pluralize(len(comments), "comment", "comments"))
+	for _, c := range comments {
+		loc := "discussion"
+		if !c.Discussion {
+			loc = c.Item.Ref + "  " + c.Item.Path
+			if c.Item.Line > 0 {
+				loc = fmt.Sprintf("%s:%d", loc, c.Item.Line)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "  %s  %s  @%s\n", c.Item.CommentID, loc, c.Item.Author)
+	}
+
+	return confirmSelection(verb, "comments", yes)
+}
+
+// resolveCommentIDs maps --comment values to comment node IDs, fetching the
+// PR's threads at most once, and only when a value is not a node ID.
+func resolveCommentIDs(client *api.Client, pr *api.PRRef, comments []string) ([]string, error) {
+	resolver := newRefResolver(client, pr)
+
+	var ids []string
+	for _, ref := range comments {
+		if strings.TrimSpace(ref) == "" {
+			continue
+		}
+		id, err := resolver.commentID(ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("--comment or a filter is required")
+	}
+	return uniqueIDs(ids), nil
+}
+
+// setCommentsHidden hides or unhides each comment concurrently, recording
+// every outcome rather than stopping at the first failure.
+func setCommentsHidden(client *api.Client, items []output.HideItem, hide bool, reason string) output.HideResult {
+	result := output.HideResult{
+		Hidden: hide,
+		Reason: reason,
+		Items:  items,
+	}
+
+	sem := make(chan struct{}, hideWorkers)
+	var wg sync.WaitGroup
+	for i := range result.Items {
+		item := &result.Items[i]
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var err error
+			if hide {
+				err = client.MinimizeComment(item.CommentID, reason)
+			} else {
+				err = client.UnminimizeComment(item.CommentID)
+			}
+			if err != nil {
+				item.Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return result
+}
+
+// runCommentVisibilityChange hides or unhides comments named by reference or
+// selected by filter and prints the outcome.
+func runCommentVisibilityChange(cmd *cobra.Command, pr *api.PRRef, hide bool, reason string, commentRefs []string, filter *commentFilter, yes bool) error {
+	verb, state := "hide", "shown"
+	if !hide {
+		verb, state = "unhide", "hidden"
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	var items []output.HideItem
+	if filter.selecting() {
+		comments, err := selectComments(client, pr, !hide, *filter)
+		if err != nil {
+			return err
+		}
+		if len(comments) == 0 {
+			return formatter.Format(output.NoOpResult{Message: fmt.Sprintf("No %s comments match", state)})
+		}
+		if err := confirmComments(verb, comments, yes); err != nil {
+			return err
+		}
+		for _, c := range comments {
+			items = append(items, c.Item)
+		}
+	} else {
+		ids, err := resolveCommentIDs(client, pr, commentRefs)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			items = append(items, output.HideItem{CommentID: id})
+		}
+	}
+
+	result := setCommentsHidden(client, items, hide, reason)
+	if err := formatter.Format(result); err != nil {
+		return err
+	}
+
+	if failed := result.Failed(); failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d comments failed", failed, len(result.Items))
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+func TestCommentFilterMatches(t *testing.T) {
+	review := commentCandidate{
+		Item:     output.HideItem{CommentID: "PRRC_1", Author: "octocat", Path: "internal/db/store.go", Line: 12},
+		Outdated: true,
+	}
+	discussion := commentCandidate{
+		Item:       output.HideItem{CommentID: "IC_1", Author: "dependabot"},
+		Discussion: true,
+	}
+
+	tests := []struct {
+		name    string
+		filter  commentFilter
+		comment commentCandidate
+		want    bool
+	}{
+		{"empty filter", commentFilter{}, review, true},
+		{"author", commentFilter{Author: "@OctoCat"}, review, true},
+		{"other author", commentFilter{Author: "hubot"}, review, false},
+		{"path glob", commentFilter{Path: "store.go"}, review, true},
+		{"path never matches discussion", commentFilter{Path: "*"}, discussion, false},
+		{"outdated thread", commentFilter{Outdated: true}, review, true},
+		{"resolved thread", commentFilter{Resolved: true}, review, false},
+		{"outdated excludes discussion", commentFilter{Outdated: true}, discussion, false},
+		{"discussion only", commentFilter{Discussion: true}, discussion, true},
+		{"discussion excludes review", commentFilter{Discussion: true}, review, false},
+		{"discussion author", commentFilter{Discussion: true, Author: "dependabot"}, discussion, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(tt.comment); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterComments(t *testing.T) {
+	threads := []*api.Thread{
+		{Number: 1, Path: "a.go", Line: 3, IsResolved: true, Comments: []*api.ThreadComment{
+			{ID: "PRRC_1", Author: "octocat"},
+			{ID: "PRRC_2", Author: "hubot", IsMinimized: true},
+		}},
+		{Number: 2, Path: "b.go", IsOutdated: true, Comments: []*api.ThreadComment{
+			{ID: "PRRC_3", Author: "octocat"},
+		}},
+	}
+	discussion := []*api.PRComment{
+		{ID: "IC_1", Author: "octocat"},
+	}
+	candidates := commentCandidates(threads, discussion)
+
+	tests := []struct {
+		name      string
+		minimized bool
+		filter    commentFilter
+		want      []string
+	}{
+		{"all shown", false, commentFilter{All: true}, []string{"PRRC_1", "PRRC_3", "IC_1"}},
+		{"all hidden", true, commentFilter{All: true}, []string{"PRRC_2"}},
+		{"resolved", false, commentFilter{Resolved: true}, []string{"PRRC_1"}},
+		{"outdated", false, commentFilter{Outdated: true}, []string{"PRRC_3"}},
+		{"discussion", false, commentFilter{Discussion: true}, []string{"IC_1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range filterComments(candidates, tt.minimized, tt.filter) {
+				got = append(got, c.Item.CommentID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("filterComments() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("filterComments() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	if ref := candidates[1].Item.Ref; ref != "c1.2" {
+		t.Errorf("second candidate ref = %q, want c1.2", ref)
+	}
+}
+
+func TestCommentFilterImpliedReason(t *testing.T) {
+	if got := (commentFilter{Outdated: true}).impliedReason(); got != "outdated" {
+		t.Errorf("impliedReason() = %q, want outdated", got)
+	}
+	if got := (commentFilter{Resolved: true}).impliedReason(); got != "resolved" {
+		t.Errorf("impliedReason() = %q, want resolved", got)
+	}
+	if got := (commentFilter{Author: "octocat"}).impliedReason(); got != "" {
+		t.Errorf("impliedReason() = %q, want empty", got)
+	}
+}
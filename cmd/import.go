@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/findings"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [<number>] <report>",
+	Short: "Import linter findings as draft comments",
+	Long: `Import static analysis findings as comments in your pending review.
+
+Reads SARIF 2.1, checkstyle XML, or reviewdog rdjson/rdjsonl (use "-" for
+stdin). Multi-line regions become multi-line comments. Findings that already
+have a matching comment on the PR are skipped, so re-running an import is
+safe. Findings on lines outside the PR diff are skipped as well.`,
+	Example: `  gh review import 123 golangci.sarif
+  gh review import golangci.sarif
+  semgrep --sarif | gh review import 123 -
+  gh review import 123 checkstyle.xml --report-format checkstyle`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runImport,
+}
+
+var (
+	importReportFormat string
+	importRoot         string
+	importReviewID     string
+)
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringVar(&importReportFormat, "report-format", "", "Report format: sarif, checkstyle, rdjson, rdjsonl (default: detect)")
+	importCmd.Flags().StringVar(&importRoot, "root", "", "Directory report paths are relative to (default: git top-level)")
+	importCmd.Flags().StringVar(&importReviewID, "review-id", "", "Explicit review ID (GraphQL node ID)")
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args[:len(args)-1])
+	if err != nil {
+		return err
+	}
+
+	format, err := findings.ParseFormat(importReportFormat)
+	if err != nil {
+		return err
+	}
+
+	found, err := readFindings(args[len(args)-1], format)
+	if err != nil {
+		return err
+	}
+	if len(found) == 0 {
+		formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+		if err != nil {
+			return err
+		}
+		return formatter.Format(output.NoOpResult{Message: "No findings to import"})
+	}
+
+	root := importRoot
+	if root == "" {
+		root = gitTopLevel()
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	threads, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+	if err != nil {
+		return err
+	}
+	seen := existingComments(threads.Threads)
+
+	prDiff, err := client.PullRequestDiff(pr)
+	if err != nil {
+		return err
+	}
+
+	result := output.BatchAddResult{
+		Items: make([]output.BatchAddItem, len(found)),
+	}
+	inputs := make([]*api.AddThreadInput, len(found))
+
+	for i, f := range found {
+		f.Path = findings.RelativePath(f.Path, root)
+		body := f.Body()
+
+		result.Items[i] = output.BatchAddItem{
+			Record: i + 1,
+			Path:   f.Path,
+			Line:   f.Line,
+		}
+
+		key := commentKey(f.Path, f.Line, body)
+		if seen[key] {
+			result.Items[i].Skipped = "duplicate"
+			continue
+		}
+
+		input := &api.AddThreadInput{
+			Path: f.Path,
+			Line: f.Line,
+			Body: body,
+		}
+		if f.StartLine > 0 {
+			startLine := f.StartLine
+			input.StartLine = &startLine
+		}
+		if err := prDiff.Validate(anchorFor(input)); err != nil {
+			result.Items[i].Skipped = "not in diff"
+			continue
+		}
+
+		seen[key] = true
+		inputs[i] = input
+	}
+
+	if result.Added() > 0 {
+		if err := addThreads(client, pr, importReviewID, &result, inputs); err != nil {
+			return err
+		}
+	}
+
+	return formatBatchResult(cmd, result)
+}
+
+func readFindings(name string, format findings.Format) ([]findings.Finding, error) {
+	if name == "-" {
+		return findings.Parse(os.Stdin, name, format)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return findings.Parse(f, name, format)
+}
+
+// existingComments indexes every comment already on the PR so imported
+// findings are not posted twice.
+func existingComments(threads []*api.Thread) map[string]bool {
+	seen := make(map[string]bool)
+	for _, t := range threads {
+		for _, c := range t.Comments {
+			seen[commentKey(t.Path, t.Line, c.Body)] = true
+		}
+	}
+	return seen
+}
+
+func commentKey(path string, line int, body string) string {
+	return fmt.Sprintf("%s:%d:%s", path, line, strings.TrimSpace(body))
+}
+
+// gitTopLevel returns the root of the current git checkout, or "" outside
+// of one.
+func gitTopLevel() string {
+	out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func TestExistingComments(t *testing.T) {
+	threads := []*api.Thread{
+		{
+			Path: "a.go",
+			Line: 10,
+			Comments: []*api.ThreadComment{
+				{Body: "**errcheck**: unchecked\n"},
+				{Body: "Fixed"},
+			},
+		},
+	}
+
+	seen := existingComments(threads)
+
+	if !seen[commentKey("a.go", 10, "**errcheck**: unchecked")] {
+		t.Error("expected head comment to be indexed, ignoring surrounding whitespace")
+	}
+	if !seen[commentKey("a.go", 10, "Fixed")] {
+		t.Error("expected reply to be indexed")
+	}
+	if seen[commentKey("a.go", 11, "Fixed")] {
+		t.Error("comment on a different line should not match")
+	}
+}
+
+func TestImportCmdArgs(t *testing.T) {
+	if err := importCmd.Args(importCmd, nil); err == nil {
+		t.Error("import: expected error without a report argument")
+	}
+	if err := importCmd.Args(importCmd, []string{"123", "a.sarif", "b.sarif"}); err == nil {
+		t.Error("import: expected error for more than one report")
+	}
+	if importCmd.Flags().Lookup("report-format") == nil {
+		t.Error("import: report-format flag not registered")
+	}
+}
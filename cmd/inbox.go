@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+// inboxWorkers bounds the PRs inspected concurrently.
+const inboxWorkers = 4
+
+var inboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "List PRs awaiting your attention",
+	Long: `List open pull requests across GitHub that await you:
+
+  - your review is requested, directly or through a team
+  - you have a pending review with unsubmitted draft comments
+  - you authored the PR and unresolved threads end with someone else's comment
+
+Candidates come from GitHub search: PRs requesting your review, PRs you
+reviewed and PRs you authored. Drafts on a PR outside these searches are
+not found. Use -R to search a single repository.`,
+	Example: `  gh review inbox
+  gh review inbox -R owner/repo
+  gh review inbox --format=json`,
+	Args: cobra.NoArgs,
+	RunE: runInbox,
+}
+
+var inboxLimit int
+
+func init() {
+	rootCmd.AddCommand(inboxCmd)
+	inboxCmd.Flags().IntVarP(&inboxLimit, "limit", "L", 50, "Maximum PRs fetched per search")
+}
+
+// inboxCandidate is a PR found by the inbox searches.
+type inboxCandidate struct {
+	pr        *api.SearchPR
+	requested bool
+	authored  bool
+}
+
+func runInbox(cmd *cobra.Command, args []string) error {
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	viewer, err := client.ViewerLogin()
+	if err != nil {
+		return err
+	}
+
+	scope := "is:open archived:false"
+	if repoFlag != "" {
+		owner, name, err := api.ParseRepo(repoFlag)
+		if err != nil {
+			return err
+		}
+		scope += fmt.Sprintf(" repo:%s/%s", owner, name)
+	}
+
+	candidates, err := searchInbox(client, scope, inboxLimit)
+	if err != nil {
+		return err
+	}
+
+	items, err := inboxItems(client, viewer, candidates)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(output.InboxResult{Items: items})
+}
+
+// searchInbox runs the inbox searches concurrently and merges their results
+// by PR.
+func searchInbox(client *api.Client, scope string, limit int) ([]*inboxCandidate, error) {
+	queries := []string{
+		"review-requested:@me",
+		"reviewed-by:@me",
+		"author:@me",
+	}
+
+	results := make([][]*api.SearchPR, len(queries))
+	errs := make([]error, len(queries))
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = client.SearchPullRequests(scope+" "+q, limit)
+		}()
+	}
+	wg.Wait()
+
+	var candidates []*inboxCandidate
+	byRef := make(map[string]*inboxCandidate)
+	for i, prs := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for _, pr := range prs {
+			key := strings.ToLower(pr.Ref.String())
+			c, ok := byRef[key]
+			if !ok {
+				c = &inboxCandidate{pr: pr}
+				byRef[key] = c
+				candidates = append(candidates, c)
+			}
+			switch queries[i] {
+			case "review-requested:@me":
+				c.requested = true
+			case "author:@me":
+				c.authored = true
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// inboxItems inspects the candidates concurrently, counting the viewer's
+// draft comments and, on their own PRs, the unresolved threads awaiting a
+// reply. PRs with nothing to do are dropped; the rest are ordered by last
+// update, newest first.
+func inboxItems(client *api.Client, viewer string, candidates []*inboxCandidate) ([]output.InboxItem, error) {
+	items := make([]*output.InboxItem, len(candidates))
+	errs := make([]error, len(candidates))
+
+	sem := make(chan struct{}, inboxWorkers)
+	var wg sync.WaitGroup
+	for i, c := range candidates {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			items[i], errs[i] = inspectInboxPR(client, viewer, c)
+		}()
+	}
+	wg.Wait()
+
+	var result []output.InboxItem
+	for i, item := range items {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("%s: %w", candidates[i].pr.Ref, errs[i])
+		}
+		if item != nil {
+			result = append(result, *item)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].UpdatedAt.After(result[j].UpdatedAt)
+	})
+	return result, nil
+}
+
+// inspectInboxPR returns the inbox entry for a candidate, or nil when it
+// needs nothing from the viewer.
+func inspectInboxPR(client *api.Client, viewer string, c *inboxCandidate) (*output.InboxItem, error) {
+	reviews, err := client.PendingReviews(c.pr.Ref, api.PendingReviewsOptions{Reviewer: viewer})
+	if err != nil {
+		return nil, err
+	}
+	drafts := 0
+	for _, r := range reviews {
+		drafts += r.TotalCount
+	}
+
+	unanswered := 0
+	if c.authored {
+		threads, err := client.ReviewThreads(c.pr.Ref, api.ReviewThreadsOptions{UnresolvedOnly: true})
+		if err != nil {
+			return nil, err
+		}
+		unanswered = countUnanswered(threads.Threads, viewer)
+	}
+
+	if !c.requested && drafts == 0 && unanswered == 0 {
+		return nil, nil
+	}
+	return &output.InboxItem{
+		PRRef:           c.pr.Ref.String(),
+		Title:           c.pr.Title,
+		URL:             c.pr.URL,
+		Author:          c.pr.Author,
+		UpdatedAt:       c.pr.UpdatedAt,
+		ReviewRequested: c.requested,
+		Drafts:          drafts,
+		Unanswered:      unanswered,
+	}, nil
+}
+
+// countUnanswered counts the unresolved threads on the viewer's PR whose
+// last submitted comment is someone else's.
+func countUnanswered(threads []*api.Thread, viewer string) int {
+	n := 0
+	for _, t := range threads {
+		if t.AwaitingReplyFrom(viewer, true) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func TestCountUnanswered(t *testing.T) {
+	threads := []*api.Thread{
+		{Comments: []*api.ThreadComment{{Author: "reviewer"}}},
+		{Comments: []*api.ThreadComment{{Author: "reviewer"}, {Author: "Me"}}},
+		{Comments: []*api.ThreadComment{{Author: "reviewer"}, {Author: "me"}, {Author: "reviewer"}}},
+		{Comments: []*api.ThreadComment{{Author: "reviewer"}, {Author: "me", State: "pending"}}},
+		{IsResolved: true, Comments: []*api.ThreadComment{{Author: "reviewer"}}},
+		{},
+	}
+
+	if got := countUnanswered(threads, "me"); got != 3 {
+		t.Errorf("countUnanswered() = %d, want 3", got)
+	}
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+	"github.com/srnnkls/gh-review/internal/readstate"
+)
+
+var markReadCmd = &cobra.Command{
+	Use:   "mark-read [<number>]",
+	Short: "Mark a PR's comments as read",
+	Long: `Remember the PR's latest comment as read, so that --new on 'view' and
+'comments' shows only comments made after it.
+
+Read state is kept locally, in one file per repository under the gh state
+directory ($XDG_STATE_HOME/gh/gh-review/read, ~/.local/state/gh/gh-review/read
+by default). --clear forgets it, making every comment new again.`,
+	Example: `  gh review mark-read 123
+  gh review mark-read 123 --clear`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMarkRead,
+}
+
+var markReadClear bool
+
+func init() {
+	rootCmd.AddCommand(markReadCmd)
+	markReadCmd.Flags().BoolVar(&markReadClear, "clear", false, "Forget the read state, making every comment new")
+}
+
+func runMarkRead(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args)
+	if err != nil {
+		return err
+	}
+
+	store, err := readstate.Load(readstate.Path(pr.Owner, pr.Repo))
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	if markReadClear {
+		store.Clear(pr.Number)
+		if err := store.Save(); err != nil {
+			return err
+		}
+		return formatter.Format(output.MarkReadResult{PRRef: pr.String(), Cleared: true})
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	threads, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+	if err != nil {
+		return err
+	}
+	discussion, _, err := client.PRComments(pr, 0)
+	if err != nil {
+		return err
+	}
+
+	mark, err := markRead(store, pr, latestComment(threads.Threads, discussion))
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(output.MarkReadResult{
+		PRRef:     pr.String(),
+		CommentID: mark.CommentID,
+		At:        mark.CreatedAt,
+	})
+}
+
+// markRead records mark as the PR's read mark and saves the store. A mark
+// is never moved back.
+func markRead(store *readstate.Store, pr *api.PRRef, mark readstate.Mark) (readstate.Mark, error) {
+	if prev, ok := store.Get(pr.Number); ok && prev.CreatedAt.After(mark.CreatedAt) {
+		mark.CommentID, mark.CreatedAt = prev.CommentID, prev.CreatedAt
+	}
+	mark.MarkedAt = time.Now().UTC()
+
+	store.Set(pr.Number, mark)
+	if err := store.Save(); err != nil {
+		return readstate.Mark{}, err
+	}
+	return mark, nil
+}
+
+// latestComment finds the most recently created comment, leaving out
+// drafts. The mark is empty when there are no comments.
+func latestComment(threads []*api.Thread, discussion []*api.PRComment) readstate.Mark {
+	var mark readstate.Mark
+	consider := func(id string, at time.Time) {
+		if at.After(mark.CreatedAt) {
+			mark.CommentID, mark.CreatedAt = id, at
+		}
+	}
+	for _, t := range threads {
+		for _, c := range t.Comments {
+			if c.State != "pending" {
+				consider(c.ID, c.CreatedAt)
+			}
+		}
+	}
+	for _, c := range discussion {
+		consider(c.ID, c.CreatedAt)
+	}
+	return mark
+}
+
+// readFilter keeps the comments made after the PR was last marked read.
+// SinceLast also marks the PR read up to the newest comment shown. The mark
+// is a single time, so it covers every older comment too; SinceLast is
+// therefore exclusive with the flags that narrow what is shown.
+type readFilter struct {
+	New       bool
+	SinceLast bool
+
+	// pr, store and mark are filled by prepare.
+	pr    *api.PRRef
+	store *readstate.Store
+	mark  readstate.Mark
+	// latest is the newest submitted comment passed to shown.
+	latest readstate.Mark
+}
+
+// addReadFlags adds --new and --since-last, rejecting --since-last with each
+// of the narrowing flags, which must already be defined.
+func addReadFlags(cmd *cobra.Command, f *readFilter, narrowing ...string) {
+	cmd.Flags().BoolVar(&f.New, "new", false, "Show only comments made since the PR was marked read")
+	cmd.Flags().BoolVar(&f.SinceLast, "since-last", false, "Like --new, then mark the PR read")
+	cmd.MarkFlagsMutuallyExclusive("new", "since-last")
+	for _, name := range narrowing {
+		cmd.MarkFlagsMutuallyExclusive("since-last", name)
+	}
+}
+
+// active reports whether either filter flag was given.
+func (f readFilter) active() bool {
+	return f.New || f.SinceLast
+}
+
+// prepare loads the PR's read mark.
+func (f *readFilter) prepare(pr *api.PRRef) error {
+	if !f.active() {
+		return nil
+	}
+	store, err := readstate.Load(readstate.Path(pr.Owner, pr.Repo))
+	if err != nil {
+		return err
+	}
+	f.pr, f.store = pr, store
+	f.mark, _ = store.Get(pr.Number)
+	return nil
+}
+
+// unread reports whether a comment created at t passes the filter; every
+// comment does when it is inactive.
+func (f readFilter) unread(t time.Time) bool {
+	return !f.active() || f.mark.Unread(t)
+}
+
+// shown records a comment that was printed. Drafts are left out, as they
+// are by mark-read.
+func (f *readFilter) shown(id, state string, at time.Time) {
+	if state != "pending" && at.After(f.latest.CreatedAt) {
+		f.latest.CommentID, f.latest.CreatedAt = id, at
+	}
+}
+
+// finish marks the PR read up to the newest comment shown with SinceLast.
+// Nothing is marked when no comment was shown, or when truncated results
+// may have left older unread comments out.
+func (f readFilter) finish(truncated bool) error {
+	if !f.SinceLast || f.latest.CreatedAt.IsZero() {
+		return nil
+	}
+	if truncated {
+		fmt.Fprintln(os.Stderr, "Warning: results truncated; not marking the PR read. Use --limit=0 to fetch all.")
+		return nil
+	}
+	_, err := markRead(f.store, f.pr, f.latest)
+	return err
+}
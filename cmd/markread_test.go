@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/readstate"
+)
+
+func TestLatestComment(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 3, 1, 9, min, 0, 0, time.UTC) }
+	threads := []*api.Thread{{Comments: []*api.ThreadComment{
+		{ID: "PRRC_1", State: "commented", CreatedAt: at(5)},
+		{ID: "PRRC_2", State: "pending", CreatedAt: at(30)},
+	}}}
+	discussion := []*api.PRComment{{ID: "IC_1", CreatedAt: at(10)}}
+
+	if got := latestComment(threads, discussion); got.CommentID != "IC_1" || !got.CreatedAt.Equal(at(10)) {
+		t.Errorf("latestComment() = %+v, want IC_1", got)
+	}
+	if got := latestComment(nil, nil); got.CommentID != "" || !got.CreatedAt.IsZero() {
+		t.Errorf("latestComment() without comments = %+v", got)
+	}
+}
+
+func TestMarkReadNeverMovesBack(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 3, 1, 9, min, 0, 0, time.UTC) }
+	store, err := readstate.Load(filepath.Join(t.TempDir(), "repo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr := &api.PRRef{Owner: "o", Repo: "r", Number: 1}
+	store.Set(1, readstate.Mark{CommentID: "IC_9", CreatedAt: at(50)})
+
+	mark, err := markRead(store, pr, readstate.Mark{CommentID: "PRRC_1", CreatedAt: at(5)})
+	if err != nil {
+		t.Fatalf("markRead() unexpected error: %v", err)
+	}
+	if mark.CommentID != "IC_9" || mark.MarkedAt.IsZero() {
+		t.Errorf("markRead() = %+v, want the later mark kept", mark)
+	}
+}
+
+func TestReadFilterUnread(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	if !(readFilter{}).unread(time.Time{}) {
+		t.Error("an inactive filter should keep every comment")
+	}
+	f := readFilter{New: true, mark: readstate.Mark{CreatedAt: at}}
+	if f.unread(at) || !f.unread(at.Add(time.Minute)) {
+		t.Error("only comments after the mark should be unread")
+	}
+}
+
+func TestReadFilterFinishMarksShownComments(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 3, 1, 9, min, 0, 0, time.UTC) }
+	store, err := readstate.Load(filepath.Join(t.TempDir(), "repo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := readFilter{SinceLast: true, pr: &api.PRRef{Owner: "o", Repo: "r", Number: 1}, store: store}
+
+	if err := f.finish(false); err != nil {
+		t.Fatalf("finish() unexpected error: %v", err)
+	}
+	if _, ok := store.Get(1); ok {
+		t.Error("finish() without shown comments should not set a mark")
+	}
+
+	f.shown("PRRC_1", "commented", at(5))
+	if err := f.finish(true); err != nil {
+		t.Fatalf("finish() unexpected error: %v", err)
+	}
+	if _, ok := store.Get(1); ok {
+		t.Error("finish() with truncated results should not set a mark")
+	}
+
+	f.shown("PRRC_2", "pending", at(30))
+	f.shown("IC_1", "discussion", at(10))
+	if err := f.finish(false); err != nil {
+		t.Fatalf("finish() unexpected error: %v", err)
+	}
+	if mark, _ := store.Get(1); mark.CommentID != "IC_1" || !mark.CreatedAt.Equal(at(10)) {
+		t.Errorf("mark = %+v, want IC_1, the newest shown submitted comment", mark)
+	}
+}
+
+func TestReadFlagsRejectNarrowing(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().String("author", "", "")
+		addReadFlags(cmd, &readFilter{}, "author")
+		return cmd
+	}
+
+	tests := []struct {
+		args    []string
+		wantErr bool
+	}{
+		{[]string{"--since-last"}, false},
+		{[]string{"--new", "--author", "a"}, false},
+		{[]string{"--since-last", "--author", "a"}, true},
+	}
+	for _, tt := range tests {
+		cmd := newCmd()
+		if err := cmd.ParseFlags(tt.args); err != nil {
+			t.Fatal(err)
+		}
+		if err := cmd.ValidateFlagGroups(); (err != nil) != tt.wantErr {
+			t.Errorf("%v: ValidateFlagGroups() error = %v, wantErr %v", tt.args, err, tt.wantErr)
+		}
+	}
+}
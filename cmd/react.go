@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var reactCmd = &cobra.Command{
+	Use:   "react [<number>]",
+	Short: "React to comments with an emoji",
+	Long: `Add an emoji reaction to review comments or PR discussion comments, or
+take yours back with --remove.
+
+Name comments with --comment, which may be repeated or take a
+comma-separated list of c3.2 references, comment IDs, URLs or node IDs.
+The reaction defaults to 👍, the usual acknowledgement; 'view' and
+'comments' list threads the PR author has neither reacted to nor replied to
+with --unacknowledged.`,
+	Example: `  gh review react 123 -c c3.1
+  gh review react 123 -c c3.1,c5.2 --reaction rocket
+  gh review react 123 -c IC_xxx --reaction eyes
+  gh review react 123 -c c3.1 --remove`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReact,
+}
+
+var (
+	reactComments []string
+	reactReaction string
+	reactRemove   bool
+)
+
+func init() {
+	rootCmd.AddCommand(reactCmd)
+	reactCmd.Flags().StringSliceVarP(&reactComments, "comment", "c", nil, "Comments to react to: c3.2, comment ID, URL or node ID (required)")
+	reactCmd.Flags().StringVar(&reactReaction, "reaction", "+1", fmt.Sprintf("Reaction: %s, or the emoji itself", strings.Join(api.Reactions, ", ")))
+	reactCmd.Flags().BoolVar(&reactRemove, "remove", false, "Remove your reaction instead of adding it")
+
+	reactCmd.MarkFlagRequired("comment")
+}
+
+func runReact(cmd *cobra.Command, args []string) error {
+	reaction, err := api.ParseReaction(reactReaction)
+	if err != nil {
+		return err
+	}
+
+	pr, err := resolvePRArgs(args, reactComments...)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	ids, err := resolveCommentIDs(client, pr, reactComments)
+	if err != nil {
+		return err
+	}
+
+	result := output.ReactResult{
+		Reaction: reaction,
+		Emoji:    api.ReactionEmoji(reaction),
+		Removed:  reactRemove,
+	}
+	for _, id := range ids {
+		if reactRemove {
+			err = client.RemoveReaction(id, reaction)
+		} else {
+			err = client.AddReaction(id, reaction)
+		}
+		item := output.ReactItem{CommentID: id}
+		if err != nil {
+			item.Error = err.Error()
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	if err := formatter.Format(result); err != nil {
+		return err
+	}
+
+	if failed := result.Failed(); failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d reactions failed", failed, len(result.Items))
+	}
+
+	return nil
+}
+
+// outputReactions converts reaction counts for display.
+func outputReactions(reactions []api.Reaction) []output.Reaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+	out := make([]output.Reaction, len(reactions))
+	for i, r := range reactions {
+		out[i] = output.Reaction{
+			Name:   r.Name,
+			Emoji:  api.ReactionEmoji(r.Name),
+			Count:  r.Count,
+			Viewer: r.Viewer,
+		}
+	}
+	return out
+}
+
+// threadAcknowledged reports whether login, normally the PR author, took
+// part in a thread: by starting or replying to it, or by reacting to any of
+// its comments.
+func threadAcknowledged(t *api.Thread, login string) bool {
+	for _, c := range t.Comments {
+		if strings.EqualFold(c.Author, login) || api.ReactedBy(c.Reactions, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// completeReactions fills in the reactors GitHub left out of busy reaction
+// groups, so threadAcknowledged and ReactedBy see every user. Comments
+// login is already known to have reacted to are left alone.
+func completeReactions(client *api.Client, threads []*api.Thread, discussion []*api.PRComment, login string) error {
+	complete := func(id string, reactions *[]api.Reaction) error {
+		if !api.ReactionsTruncated(*reactions) || api.ReactedBy(*reactions, login) {
+			return nil
+		}
+		full, err := client.CompleteReactions(id, *reactions)
+		if err != nil {
+			return err
+		}
+		*reactions = full
+		return nil
+	}
+	for _, t := range threads {
+		for _, c := range t.Comments {
+			if err := complete(c.ID, &c.Reactions); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range discussion {
+		if err := complete(c.ID, &c.Reactions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prAuthor looks up the login of the PR's author.
+func prAuthor(client *api.Client, pr *api.PRRef) (string, error) {
+	identity, err := client.ResolvePR(pr)
+	if err != nil {
+		return "", err
+	}
+	if identity.Author == "" {
+		return "", fmt.Errorf("PR %s has no author (deleted account?)", pr)
+	}
+	return identity.Author, nil
+}
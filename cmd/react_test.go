@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+func TestThreadAcknowledged(t *testing.T) {
+	thumbsUp := []api.Reaction{{Name: "+1", Count: 1, Users: []string{"author"}}}
+
+	tests := []struct {
+		name   string
+		thread *api.Thread
+		want   bool
+	}{
+		{"no reply or reaction", &api.Thread{Comments: []*api.ThreadComment{
+			{Author: "reviewer"},
+		}}, false},
+		{"replied", &api.Thread{Comments: []*api.ThreadComment{
+			{Author: "reviewer"},
+			{Author: "Author"},
+		}}, true},
+		{"reacted", &api.Thread{Comments: []*api.ThreadComment{
+			{Author: "reviewer", Reactions: thumbsUp},
+		}}, true},
+		{"someone else reacted", &api.Thread{Comments: []*api.ThreadComment{
+			{Author: "reviewer", Reactions: []api.Reaction{{Name: "+1", Count: 1, Users: []string{"hubot"}}}},
+		}}, false},
+		{"started by the author", &api.Thread{Comments: []*api.ThreadComment{
+			{Author: "author"},
+		}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := threadAcknowledged(tt.thread, "author"); got != tt.want {
+				t.Errorf("threadAcknowledged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcknowledgedComments(t *testing.T) {
+	threads := []*api.Thread{
+		{ID: "PRRT_1", Comments: []*api.ThreadComment{{ID: "PRRC_1", Author: "reviewer"}, {ID: "PRRC_2", Author: "author"}}},
+		{ID: "PRRT_2", Comments: []*api.ThreadComment{{ID: "PRRC_3", Author: "reviewer"}}},
+	}
+
+	want := map[string]bool{"PRRC_1": true, "PRRC_2": true}
+	if got := acknowledgedComments(threads, "author"); !reflect.DeepEqual(got, want) {
+		t.Errorf("acknowledgedComments() = %v, want %v", got, want)
+	}
+}
+
+func TestOutputReactions(t *testing.T) {
+	if got := outputReactions(nil); got != nil {
+		t.Errorf("outputReactions(nil) = %v, want nil", got)
+	}
+
+	got := outputReactions([]api.Reaction{{Name: "+1", Count: 2, Viewer: true, Users: []string{"a", "b"}}})
+	want := []output.Reaction{{Name: "+1", Emoji: "👍", Count: 2, Viewer: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("outputReactions() = %+v, want %+v", got, want)
+	}
+}
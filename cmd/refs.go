@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+// refResolver turns --thread and --comment values into node IDs. Node IDs
+// pass through untouched; short references (t3, c3.2), comment database IDs
+// and comment URLs are looked up in the PR's threads, fetched at most once.
+type refResolver struct {
+	client  *api.Client
+	pr      *api.PRRef
+	threads []*api.Thread
+	fetched bool
+}
+
+func newRefResolver(client *api.Client, pr *api.PRRef) *refResolver {
+	return &refResolver{client: client, pr: pr}
+}
+
+func (r *refResolver) allThreads() ([]*api.Thread, error) {
+	if !r.fetched {
+		result, err := r.client.ReviewThreads(r.pr, api.ReviewThreadsOptions{})
+		if err != nil {
+			return nil, err
+		}
+		r.threads, r.fetched = result.Threads, true
+	}
+	return r.threads, nil
+}
+
+// find returns the thread s refers to and, for comment references, the
+// comment.
+func (r *refResolver) find(s string) (*api.Thread, *api.ThreadComment, error) {
+	ref, err := api.ParseRef(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	threads, err := r.allThreads()
+	if err != nil {
+		return nil, nil, err
+	}
+	t, c := ref.Find(threads)
+	if t == nil {
+		if ref.IsComment() {
+			return nil, nil, fmt.Errorf("no review thread found for comment %s on %s", strings.TrimSpace(s), r.pr)
+		}
+		return nil, nil, fmt.Errorf("no review thread %s on %s", strings.TrimSpace(s), r.pr)
+	}
+	return t, c, nil
+}
+
+// threadID returns the node ID of the thread s names; comment references
+// name the thread they belong to.
+func (r *refResolver) threadID(s string) (string, error) {
+	ref, err := api.ParseRef(s)
+	if err != nil {
+		return "", err
+	}
+	if ref.NodeID != "" && !ref.IsComment() {
+		return ref.NodeID, nil
+	}
+	t, _, err := r.find(s)
+	if err != nil {
+		return "", err
+	}
+	return t.ID, nil
+}
+
+// commentID returns the node ID of the comment s names.
+func (r *refResolver) commentID(s string) (string, error) {
+	ref, err := api.ParseRef(s)
+	if err != nil {
+		return "", err
+	}
+	if ref.NodeID != "" {
+		return ref.NodeID, nil
+	}
+	if !ref.IsComment() {
+		return "", fmt.Errorf("%s names a thread; use c%d.<n> for one of its comments", strings.TrimSpace(s), ref.Thread)
+	}
+	_, c, err := r.find(s)
+	if err != nil {
+		return "", err
+	}
+	return c.ID, nil
+}
+
+// resolveCommentArg maps a --comment value to a comment node ID. The PR is
+// only resolved, from args or the reference itself, when the value is not
+// already a node ID.
+func resolveCommentArg(client *api.Client, args []string, comment string) (string, error) {
+	ref, err := api.ParseRef(comment)
+	if err != nil {
+		return "", err
+	}
+	if ref.NodeID != "" {
+		return ref.NodeID, nil
+	}
+
+	pr, err := resolvePRArgs(args, comment)
+	if err != nil {
+		return "", err
+	}
+	return newRefResolver(client, pr).commentID(comment)
+}
+
+// joinRefs concatenates reference flag values into one new slice.
+func joinRefs(lists ...[]string) []string {
+	var refs []string
+	for _, l := range lists {
+		refs = append(refs, l...)
+	}
+	return refs
+}
+
+// prFromRefs returns the PR of the first comment URL among refs, or nil.
+func prFromRefs(refs []string) (*api.PRRef, error) {
+	for _, s := range refs {
+		ref, err := api.ParseRef(s)
+		if err != nil || ref.PRNumber == 0 {
+			continue
+		}
+		return api.NewPRRef(ref.PRNumber, ref.Repo)
+	}
+	return nil, nil
+}
@@ -1,60 +1,137 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/diff"
 	"github.com/srnnkls/gh-review/internal/output"
+	"github.com/srnnkls/gh-review/internal/suggestion"
 )
 
 var replyCmd = &cobra.Command{
-	Use:   "reply <number>",
+	Use:   "reply [<number>]",
 	Short: "Reply to a review thread",
 	Long: `Post a reply to an existing review thread.
 
-Identify the thread by a comment ID from 'comments --ids' (--comment) or by
-its thread node ID (--thread).`,
+Identify the thread by one of its comments (--comment: c3.2, a comment ID,
+its URL or node ID) or directly (--thread: t3 or its node ID).
+
+With --suggest-file (or --suggest to read stdin), the reply carries a
+suggested change replacing the lines the thread is attached to, which
+must be lines of the new file (RIGHT side).
+
+With --editor, the reply is written in your editor with the thread's code
+and conversation shown below for reference.
+
+With --draft, the reply is added to your pending review instead of being
+posted, so it is published together with the review's other comments on
+submit. A pending review is created when none exists.`,
 	Example: `  gh review reply 123 -c PRRC_xxx -b "Done in abc1234"
-  gh review reply 123 --thread PRRT_xxx -b "Fixed, thanks"`,
-	Args: cobra.ExactArgs(1),
+  gh review reply 123 --thread PRRT_xxx -b "Fixed, thanks"
+  gh review reply --thread t3 -b "Fixed, thanks"
+  gh review reply -c https://github.com/owner/repo/pull/123#discussion_r456 -b "Done"
+  gh review reply 123 -c PRRC_xxx -b "How about:" --suggest-file fixed.txt
+  gh review reply 123 -c PRRC_xxx -e
+  gh review reply 123 --thread t3 -b "Will fix" --draft`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runReply,
 }
 
 var (
-	replyComment string
-	replyThread  string
-	replyBody    string
+	replyComment     string
+	replyThread      string
+	replyBody        string
+	replySuggestFile string
+	replySuggest     bool
+	replyEditor      bool
+	replyDraft       bool
+	replyReviewID    string
 )
 
 func init() {
 	rootCmd.AddCommand(replyCmd)
-	replyCmd.Flags().StringVarP(&replyComment, "comment", "c", "", "Comment node ID to reply under (from 'comments --ids')")
-	replyCmd.Flags().StringVar(&replyThread, "thread", "", "Thread node ID to reply to")
-	replyCmd.Flags().StringVarP(&replyBody, "body", "b", "", "Reply body (required)")
+	replyCmd.Flags().StringVarP(&replyComment, "comment", "c", "", "Comment to reply under: c3.2, comment ID, URL or node ID")
+	replyCmd.Flags().StringVar(&replyThread, "thread", "", "Thread to reply to: t3 or node ID")
+	replyCmd.Flags().StringVarP(&replyBody, "body", "b", "", "Reply body (required unless suggesting a change)")
+	replyCmd.Flags().StringVar(&replySuggestFile, "suggest-file", "", "Suggest replacing the thread's lines with this file's contents")
+	replyCmd.Flags().BoolVar(&replySuggest, "suggest", false, "Suggest replacing the thread's lines with text read from stdin")
 
-	replyCmd.MarkFlagRequired("body")
+	replyCmd.Flags().BoolVarP(&replyEditor, "editor", "e", false, "Write the reply in your editor")
+	replyCmd.Flags().BoolVar(&replyDraft, "draft", false, "Add the reply to your pending review instead of posting it")
+	replyCmd.Flags().StringVar(&replyReviewID, "review-id", "", "Pending review to add a draft reply to (implies --draft)")
+
+	replyCmd.MarkFlagsMutuallyExclusive("suggest-file", "suggest")
+	replyCmd.MarkFlagsMutuallyExclusive("suggest", "editor")
 }
 
 func runReply(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
+	if api.IsIssueCommentID(replyComment) {
+		return fmt.Errorf("%s is a PR discussion comment, which has no thread to reply to; use 'gh review comment' instead", replyComment)
+	}
+
+	pr, err := resolvePRArgs(args, replyThread, replyComment)
 	if err != nil {
 		return err
 	}
 
-	client, err := api.NewClient()
+	replacement, hasSuggestion, err := readSuggestion(replySuggestFile, replySuggest)
 	if err != nil {
 		return err
 	}
 
-	threadID, err := resolveThreadID(client, pr, replyThread, replyComment)
+	body := replyBody
+	if hasSuggestion {
+		if body, err = suggestedBody(replyBody, "", replacement); err != nil {
+			return err
+		}
+	} else if body == "" && !replyEditor {
+		return fmt.Errorf("--body is required (or use --editor, --suggest-file or --suggest)")
+	}
+
+	client, err := api.NewClient()
 	if err != nil {
 		return err
 	}
 
+	var threadID string
+	if hasSuggestion || replyEditor {
+		thread, err := lookupThread(client, pr, replyThread, replyComment)
+		if err != nil {
+			return err
+		}
+		if hasSuggestion {
+			if err := checkSuggestionThread(thread); err != nil {
+				return err
+			}
+		}
+		threadID = thread.ID
+
+		if replyEditor {
+			if body, err = composeBody(body, threadContext(thread)); err != nil {
+				return err
+			}
+		}
+	} else {
+		threadID, err = resolveThreadID(client, pr, replyThread, replyComment)
+		if err != nil {
+			return err
+		}
+	}
+
+	var reviewID string
+	if replyDraft || replyReviewID != "" {
+		if reviewID, err = pendingReviewID(client, pr, replyReviewID); err != nil {
+			return err
+		}
+	}
+
 	result, err := client.ReplyThread(api.ReplyThreadInput{
 		ThreadID: threadID,
-		Body:     replyBody,
+		ReviewID: reviewID,
+		Body:     body,
 	})
 	if err != nil {
 		return err
@@ -69,5 +146,28 @@ func runReply(cmd *cobra.Command, args []string) error {
 		ThreadID:  threadID,
 		CommentID: result.ID,
 		URL:       result.URL,
+		Draft:     reviewID != "",
+	})
+}
+
+// checkSuggestionThread reports whether a suggestion replying to thread can
+// replace the lines the thread is on, as add checks a new thread's lines.
+func checkSuggestionThread(thread *api.Thread) error {
+	switch {
+	case thread.IsOutdated:
+		return fmt.Errorf("thread %s is outdated; a suggestion would no longer apply", thread.ID)
+	case thread.IsFileLevel:
+		return fmt.Errorf("thread %s is on the whole file; a suggestion needs lines to replace", thread.ID)
+	}
+	err := suggestion.CheckAnchor(diff.Anchor{
+		Path:      thread.Path,
+		Line:      thread.Line,
+		Side:      thread.DiffSide,
+		StartLine: thread.StartLine,
+		StartSide: thread.StartDiffSide,
 	})
+	if err != nil {
+		return fmt.Errorf("thread %s cannot take a suggestion: %w", thread.ID, err)
+	}
+	return nil
 }
@@ -1,6 +1,10 @@
 package cmd
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
 
 func TestResolveThreadID(t *testing.T) {
 	t.Run("thread flag short-circuits without a client", func(t *testing.T) {
@@ -45,3 +49,26 @@ func TestReplyResolveCmdFlags(t *testing.T) {
 		t.Error("resolve: thread flag not registered")
 	}
 }
+
+func TestCheckSuggestionThread(t *testing.T) {
+	tests := []struct {
+		name    string
+		thread  api.Thread
+		wantErr bool
+	}{
+		{"line", api.Thread{Path: "main.go", Line: 20, DiffSide: "RIGHT"}, false},
+		{"range", api.Thread{Path: "main.go", StartLine: 18, Line: 20, DiffSide: "RIGHT", StartDiffSide: "RIGHT"}, false},
+		{"old file", api.Thread{Path: "main.go", Line: 20, DiffSide: "LEFT"}, true},
+		{"range starting in the old file", api.Thread{Path: "main.go", StartLine: 18, Line: 20, DiffSide: "RIGHT", StartDiffSide: "LEFT"}, true},
+		{"outdated", api.Thread{Path: "main.go", Line: 20, DiffSide: "RIGHT", IsOutdated: true}, true},
+		{"file level", api.Thread{Path: "main.go", IsFileLevel: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSuggestionThread(&tt.thread)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkSuggestionThread() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
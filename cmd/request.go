@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var requestCmd = &cobra.Command{
+	Use:   "request [<number>]",
+	Short: "Request reviews",
+	Long: `Request a review from users or teams, for example after pushing fixes.
+
+Name users by login and teams as org/team-slug; --reviewer may be repeated
+or take a comma-separated list. Reviewers already asked stay requested.
+
+With --re-request, everyone who submitted a review is asked to review
+again, leaving out you, the PR author and bots.`,
+	Example: `  gh review request 123 --reviewer octocat,hubot
+  gh review request 123 --reviewer my-org/backend
+  gh review request 123 --re-request`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRequest,
+}
+
+var (
+	requestReviewers []string
+	requestAgain     bool
+)
+
+func init() {
+	rootCmd.AddCommand(requestCmd)
+	requestCmd.Flags().StringSliceVarP(&requestReviewers, "reviewer", "r", nil, "Users or org/team teams to request a review from")
+	requestCmd.Flags().BoolVar(&requestAgain, "re-request", false, "Request a review from everyone who reviewed before")
+
+	requestCmd.MarkFlagsOneRequired("reviewer", "re-request")
+}
+
+func runRequest(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	identity, err := client.ResolvePR(pr)
+	if err != nil {
+		return err
+	}
+
+	reviewers := requestReviewers
+	if requestAgain {
+		reviews, err := client.Reviews(pr)
+		if err != nil {
+			return err
+		}
+		viewer, err := client.ViewerLogin()
+		if err != nil {
+			return err
+		}
+		reviewers = append(reviewers, previousReviewers(reviews, viewer, identity.Author)...)
+	}
+
+	users, teams := splitReviewers(reviewers)
+	if len(users) == 0 && len(teams) == 0 {
+		return fmt.Errorf("no one to request a review from on %s", pr)
+	}
+
+	pending, err := client.RequestReviews(api.RequestReviewsInput{
+		PRNodeID: identity.NodeID,
+		Users:    users,
+		Teams:    teams,
+	})
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(output.RequestResult{
+		PRRef:     pr.String(),
+		Requested: append(users, teams...),
+		Pending:   pending,
+	})
+}
+
+// previousReviewers lists who submitted a review, except the given logins.
+func previousReviewers(reviews []*api.Review, except ...string) []string {
+	var reviewers []string
+	for _, login := range api.Reviewers(reviews) {
+		if !containsFold(except, login) {
+			reviewers = append(reviewers, login)
+		}
+	}
+	return reviewers
+}
+
+// splitReviewers separates user logins from org/team names, dropping blanks,
+// "@" prefixes and repeats.
+func splitReviewers(reviewers []string) (users, teams []string) {
+	seen := make(map[string]bool)
+	for _, r := range reviewers {
+		r = strings.TrimPrefix(strings.TrimSpace(r), "@")
+		key := strings.ToLower(r)
+		if r == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		if strings.Contains(r, "/") {
+			teams = append(teams, r)
+		} else {
+			users = append(users, r)
+		}
+	}
+	return users, teams
+}
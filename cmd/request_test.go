@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func TestSplitReviewers(t *testing.T) {
+	users, teams := splitReviewers([]string{"octocat", " @hubot", "acme/backend", "", "Octocat", "@acme/Backend"})
+
+	if want := []string{"octocat", "hubot"}; !reflect.DeepEqual(users, want) {
+		t.Errorf("users = %v, want %v", users, want)
+	}
+	if want := []string{"acme/backend"}; !reflect.DeepEqual(teams, want) {
+		t.Errorf("teams = %v, want %v", teams, want)
+	}
+}
+
+func TestPreviousReviewers(t *testing.T) {
+	reviews := []*api.Review{
+		{Author: "alice", State: "approved"},
+		{Author: "Me", State: "commented"},
+		{Author: "author", State: "commented"},
+		{Author: "bob", State: "changes_requested"},
+	}
+
+	got := previousReviewers(reviews, "me", "author")
+	if want := []string{"alice", "bob"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("previousReviewers() = %v, want %v", got, want)
+	}
+}
+
+func TestDismissableReview(t *testing.T) {
+	latest := []*api.Review{
+		{ID: "PRR_1", Author: "alice", State: "approved"},
+		{ID: "PRR_2", Author: "bob", State: "commented"},
+	}
+
+	tests := []struct {
+		name     string
+		reviewer string
+		wantID   string
+		wantErr  bool
+	}{
+		{"approval", "@Alice", "PRR_1", false},
+		{"comment only", "bob", "", true},
+		{"not a reviewer", "carol", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review, err := dismissableReview(latest, tt.reviewer)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dismissableReview() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && review.ID != tt.wantID {
+				t.Errorf("dismissableReview() = %s, want %s", review.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -1,65 +1,58 @@
 package cmd
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
-	"github.com/srnnkls/gh-review/internal/api"
-	"github.com/srnnkls/gh-review/internal/output"
 )
 
 var resolveCmd = &cobra.Command{
-	Use:   "resolve <number>",
-	Short: "Resolve a review thread",
-	Long: `Mark a review thread as resolved.
-
-Identify the thread by a comment ID from 'comments --ids' (--comment) or by
-its thread node ID (--thread).`,
+	Use:   "resolve [<number>]",
+	Short: "Resolve review threads",
+	Long: `Mark review threads as resolved.
+
+Identify threads by one of their comments (--comment) or directly
+(--thread); both flags may be repeated or take a comma-separated list.
+Besides node IDs, they accept the short references printed by 'view' and
+'comments' (t3 for a thread, c3.2 for a comment), comment database IDs and
+comment URLs.
+
+Alternatively, select unresolved threads with filters. Matching threads are
+listed for confirmation before anything is resolved; pass --yes to skip the
+prompt, which is required when stdin is not a terminal.`,
 	Example: `  gh review resolve 123 -c PRRC_xxx
-  gh review resolve 123 --thread PRRT_xxx`,
-	Args: cobra.ExactArgs(1),
+  gh review resolve 123 --thread t3,t5
+  gh review resolve 123 --thread PRRT_xxx,PRRT_yyy
+  gh review resolve 123 --outdated
+  gh review resolve 123 --last-by-pr-author --path 'internal/*'
+  gh review resolve 123 --author octocat --states=changes_requested --yes`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runResolve,
 }
 
 var (
-	resolveComment string
-	resolveThread  string
+	resolveComments []string
+	resolveThreads  []string
+	resolveFilter   threadFilter
+	resolveYes      bool
 )
 
 func init() {
 	rootCmd.AddCommand(resolveCmd)
-	resolveCmd.Flags().StringVarP(&resolveComment, "comment", "c", "", "Comment node ID whose thread to resolve (from 'comments --ids')")
-	resolveCmd.Flags().StringVar(&resolveThread, "thread", "", "Thread node ID to resolve")
+	resolveCmd.Flags().StringSliceVarP(&resolveComments, "comment", "c", nil, "Comments whose threads to resolve: c3.2, comment ID, URL or node ID")
+	resolveCmd.Flags().StringSliceVar(&resolveThreads, "thread", nil, "Threads to resolve: t3 or node ID")
+	addThreadFilterFlags(resolveCmd, &resolveFilter, "unresolved")
+	resolveCmd.Flags().BoolVarP(&resolveYes, "yes", "y", false, "Resolve filtered threads without asking")
+
+	for _, filter := range threadFilterFlags {
+		resolveCmd.MarkFlagsMutuallyExclusive("comment", filter)
+		resolveCmd.MarkFlagsMutuallyExclusive("thread", filter)
+	}
 }
 
 func runResolve(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
-	if err != nil {
-		return err
-	}
-
-	client, err := api.NewClient()
-	if err != nil {
-		return err
-	}
-
-	threadID, err := resolveThreadID(client, pr, resolveThread, resolveComment)
-	if err != nil {
-		return err
-	}
-
-	result, err := client.ResolveThread(threadID)
-	if err != nil {
-		return err
-	}
-
-	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	pr, err := resolvePRArgs(args, joinRefs(resolveThreads, resolveComments)...)
 	if err != nil {
 		return err
 	}
 
-	return formatter.Format(output.ResolveResult{
-		ThreadID: result.ThreadID,
-		Resolved: result.IsResolved,
-	})
+	return runThreadStateChange(cmd, pr, true, resolveThreads, resolveComments, &resolveFilter, resolveYes)
 }
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var reviewsCmd = &cobra.Command{
+	Use:   "reviews [<number>]",
+	Short: "List submitted reviews",
+	Long: `List the reviews of a pull request: who reviewed, their verdict, when they
+submitted it, the review summary, how many comments it carried and the
+commit it was made on. Dismissed reviews are listed with the state
+"dismissed"; your own unsubmitted review shows as pending.
+
+With --latest-per-reviewer, only each reviewer's effective review is shown,
+as GitHub counts it towards merging: a comment-only review does not replace
+an earlier approval or change request.`,
+	Example: `  gh review reviews 123
+  gh review reviews 123 --latest-per-reviewer
+  gh review reviews 123 --ids --format=json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReviews,
+}
+
+var (
+	reviewsLatest bool
+	reviewsIDs    bool
+)
+
+func init() {
+	rootCmd.AddCommand(reviewsCmd)
+	reviewsCmd.Flags().BoolVar(&reviewsLatest, "latest-per-reviewer", false, "Show only each reviewer's effective review")
+	reviewsCmd.Flags().BoolVar(&reviewsIDs, "ids", false, "Include review IDs in output")
+}
+
+func runReviews(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	reviews, err := client.Reviews(pr)
+	if err != nil {
+		return err
+	}
+	if reviewsLatest {
+		reviews = api.LatestReviews(reviews)
+	}
+
+	result := output.ReviewsResult{
+		PRRef:             pr.String(),
+		LatestPerReviewer: reviewsLatest,
+		IncludeIDs:        reviewsIDs,
+	}
+	for _, r := range reviews {
+		result.Reviews = append(result.Reviews, output.ReviewItem{
+			ID:          r.ID,
+			Author:      r.Author,
+			State:       r.State,
+			Body:        r.Body,
+			SubmittedAt: r.SubmittedAt,
+			Comments:    r.CommentCount,
+			CommitOID:   r.CommitOID,
+			URL:         r.URL,
+		})
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(result)
+}
@@ -68,10 +68,10 @@ func resolveThreadID(client *api.Client, pr *api.PRRef, thread, comment string)
 	comment = strings.TrimSpace(comment)
 
 	if thread != "" {
-		return thread, nil
+		return newRefResolver(client, pr).threadID(thread)
 	}
 	if comment != "" {
-		return client.ThreadIDByComment(pr, comment)
+		return newRefResolver(client, pr).threadID(comment)
 	}
 	return "", fmt.Errorf("one of --thread or --comment is required")
 }
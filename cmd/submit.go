@@ -11,18 +11,22 @@ import (
 )
 
 var submitCmd = &cobra.Command{
-	Use:   "submit <number>",
+	Use:   "submit [<number>]",
 	Short: "Submit a pending review",
 	Long: `Submit your pending review with a verdict.
 
 Available verdicts:
   approve  - Approve the pull request
   comment  - Submit general feedback
-  request_changes - Request changes before merge`,
+  request_changes - Request changes before merge
+
+With --editor, the review summary is written in your editor, which lists the
+draft comments being submitted for reference.`,
 	Example: `  gh review submit 123 -v approve
   gh review submit 123 -R owner/repo -v comment -b "Looks good overall"
-  gh review submit 123 -v request_changes -b "Please fix the issues"`,
-	Args: cobra.ExactArgs(1),
+  gh review submit 123 -v request_changes -b "Please fix the issues"
+  gh review submit 123 -v request_changes -e`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runSubmit,
 }
 
@@ -30,6 +34,7 @@ var (
 	submitVerdict  string
 	submitBody     string
 	submitReviewID string
+	submitEditor   bool
 )
 
 func init() {
@@ -37,12 +42,13 @@ func init() {
 	submitCmd.Flags().StringVarP(&submitVerdict, "verdict", "v", "", "Review verdict: approve, comment, request_changes (required)")
 	submitCmd.Flags().StringVarP(&submitBody, "body", "b", "", "Review body/summary")
 	submitCmd.Flags().StringVar(&submitReviewID, "review-id", "", "Explicit review ID (GraphQL node ID)")
+	submitCmd.Flags().BoolVarP(&submitEditor, "editor", "e", false, "Write the review body in your editor")
 
 	submitCmd.MarkFlagRequired("verdict")
 }
 
 func runSubmit(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
+	pr, err := resolvePRArgs(args)
 	if err != nil {
 		return err
 	}
@@ -61,21 +67,29 @@ func runSubmit(cmd *cobra.Command, args []string) error {
 	}
 
 	var reviewID string
+	var review *api.PendingReview
 
 	if submitReviewID != "" {
 		reviewID = submitReviewID
 	} else {
-		review, err := client.LatestPendingReview(pr, api.PendingReviewsOptions{})
+		review, err = client.LatestPendingReview(pr, api.PendingReviewsOptions{})
 		if err != nil {
 			return err
 		}
 		reviewID = review.ID
 	}
 
+	body := submitBody
+	if submitEditor {
+		if body, err = composeBody(body, reviewContext(pr, event, review)); err != nil {
+			return err
+		}
+	}
+
 	err = client.SubmitReview(api.SubmitReviewInput{
 		ReviewID: reviewID,
 		Event:    event,
-		Body:     submitBody,
+		Body:     body,
 	})
 	if err != nil {
 		return err
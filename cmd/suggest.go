@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/srnnkls/gh-review/internal/suggestion"
+)
+
+// readSuggestion returns the replacement text for a suggested change, read
+// from file ("-" for stdin) or from stdin when fromStdin is set. ok is false
+// when no suggestion was requested.
+func readSuggestion(file string, fromStdin bool) (text string, ok bool, err error) {
+	var data []byte
+	switch {
+	case fromStdin || file == "-":
+		data, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", false, fmt.Errorf("read suggestion from stdin: %w", err)
+		}
+	case file != "":
+		data, err = os.ReadFile(file)
+		if err != nil {
+			return "", false, fmt.Errorf("read suggestion: %w", err)
+		}
+	default:
+		return "", false, nil
+	}
+	return string(data), true, nil
+}
+
+// suggestedBody puts a suggestion block after the optional body or template
+// text.
+func suggestedBody(body, template, replacement string) (string, error) {
+	if body != "" || template != "" {
+		var err error
+		if body, err = commentBody(body, template); err != nil {
+			return "", err
+		}
+	}
+	return suggestion.Append(body, replacement), nil
+}
@@ -0,0 +1,442 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+// threadStateWorkers bounds concurrent resolve/unresolve mutations.
+const threadStateWorkers = 4
+
+// threadFilter selects review threads for bulk commands. Empty fields match
+// every thread; All selects every thread without any criteria.
+type threadFilter struct {
+	Author         string
+	Path           string
+	States         []string
+	OutdatedOnly   bool
+	LastByPRAuthor bool
+	All            bool
+
+	// prAuthor is filled by prepare when LastByPRAuthor is set.
+	prAuthor string
+}
+
+// threadFilterFlags lists the flags registered by addThreadFilterFlags, so
+// commands can mark them exclusive with explicit IDs.
+var threadFilterFlags = []string{"author", "path", "states", "outdated", "last-by-pr-author", "all"}
+
+func addThreadFilterFlags(cmd *cobra.Command, f *threadFilter, state string) {
+	cmd.Flags().StringVar(&f.Author, "author", "", fmt.Sprintf("Select %s threads started by this user", state))
+	cmd.Flags().StringVar(&f.Path, "path", "", fmt.Sprintf("Select %s threads on files matching this glob", state))
+	cmd.Flags().StringSliceVar(&f.States, "states", nil, "Select threads by review state: pending, approved, changes_requested, commented")
+	cmd.Flags().BoolVar(&f.OutdatedOnly, "outdated", false, "Select only outdated threads")
+	cmd.Flags().BoolVar(&f.LastByPRAuthor, "last-by-pr-author", false, "Select threads whose last comment is by the PR author")
+	cmd.Flags().BoolVar(&f.All, "all", false, fmt.Sprintf("Select every %s thread", state))
+}
+
+// selecting reports whether any filter flag was given.
+func (f threadFilter) selecting() bool {
+	return f.All || f.Author != "" || f.Path != "" || len(f.States) > 0 || f.OutdatedOnly || f.LastByPRAuthor
+}
+
+// prepare looks up what the filter needs beyond the threads themselves.
+func (f *threadFilter) prepare(client *api.Client, pr *api.PRRef) error {
+	if !f.LastByPRAuthor || f.prAuthor != "" {
+		return nil
+	}
+	author, err := prAuthor(client, pr)
+	if err != nil {
+		return err
+	}
+	f.prAuthor = author
+	return nil
+}
+
+// matches reports whether t satisfies every set criterion. Author is the
+// login that started the thread; Path is a glob matched against the full
+// path, or against the base name when it contains no slash.
+func (f threadFilter) matches(t *api.Thread) bool {
+	if f.Author != "" {
+		if len(t.Comments) == 0 || !strings.EqualFold(t.Comments[0].Author, strings.TrimPrefix(f.Author, "@")) {
+			return false
+		}
+	}
+	if f.Path != "" && !matchPathGlob(f.Path, t.Path) {
+		return false
+	}
+	if len(f.States) > 0 && !containsFold(f.States, t.State) {
+		return false
+	}
+	if f.OutdatedOnly && !t.IsOutdated {
+		return false
+	}
+	if f.LastByPRAuthor {
+		if len(t.Comments) == 0 || !strings.EqualFold(t.Comments[len(t.Comments)-1].Author, f.prAuthor) {
+			return false
+		}
+	}
+	return true
+}
+
+// awaitingFilter keeps the unresolved threads that wait on the viewer's
+// reply (Me) or on someone else's after the viewer's (Others), for view and
+// comments.
+type awaitingFilter struct {
+	Me     bool
+	Others bool
+
+	// viewer and isAuthor are filled by prepare.
+	viewer   string
+	isAuthor bool
+}
+
+func addAwaitingFlags(cmd *cobra.Command, f *awaitingFilter) {
+	cmd.Flags().BoolVar(&f.Me, "awaiting-me", false, "Show only threads waiting on your reply")
+	cmd.Flags().BoolVar(&f.Others, "awaiting-others", false, "Show only threads where you had the last word")
+	cmd.MarkFlagsMutuallyExclusive("awaiting-me", "awaiting-others")
+}
+
+// active reports whether either filter flag was given.
+func (f awaitingFilter) active() bool {
+	return f.Me || f.Others
+}
+
+// prepare looks up the viewer and, for Me, whether they authored the PR.
+func (f *awaitingFilter) prepare(client *api.Client, pr *api.PRRef) error {
+	if !f.active() || f.viewer != "" {
+		return nil
+	}
+	viewer, err := client.ViewerLogin()
+	if err != nil {
+		return err
+	}
+	f.viewer = viewer
+	if f.Me {
+		author, err := prAuthor(client, pr)
+		if err != nil {
+			return err
+		}
+		f.isAuthor = strings.EqualFold(author, viewer)
+	}
+	return nil
+}
+
+// matches reports whether t passes the filter; every thread does when it
+// is inactive.
+func (f awaitingFilter) matches(t *api.Thread) bool {
+	switch {
+	case f.Me:
+		return t.AwaitingReplyFrom(f.viewer, f.isAuthor)
+	case f.Others:
+		return t.AwaitingOthers(f.viewer)
+	}
+	return true
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPathGlob(pattern, name string) bool {
+	if ok, _ := path.Match(pattern, name); ok {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	return false
+}
+
+// filterThreads returns the threads in the given resolution state that match f.
+func filterThreads(threads []*api.Thread, resolved bool, f threadFilter) []*api.Thread {
+	var matched []*api.Thread
+	for _, t := range threads {
+		if t.IsResolved == resolved && f.matches(t) {
+			matched = append(matched, t)
+		}
+	}
+	return matched
+}
+
+// selectThreads fetches every thread on the PR and keeps those in the given
+// resolution state that match f.
+func selectThreads(client *api.Client, pr *api.PRRef, resolved bool, f *threadFilter) ([]*api.Thread, error) {
+	if err := f.prepare(client, pr); err != nil {
+		return nil, err
+	}
+	threads, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return filterThreads(threads.Threads, resolved, *f), nil
+}
+
+// confirmThreads previews the selected threads on stderr and asks before
+// acting on them. Without a terminal to ask on, it requires --yes.
+func confirmThreads(verb string, threads []*api.Thread, yes bool) error {
+	fmt.Fprintf(os.Stderr, "Will %s %d %s:\n", verb, len(threads), pluralize(len(threads), "thread", "threads"))
+	for _, t := range threads {
+		author := ""
+		if len(t.Comments) > 0 {
+			author = "@" + t.Comments[0].Author
+		}
+		loc := t.Path
+		if t.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", t.Path, t.Line)
+		}
+		fmt.Fprintf(os.Stderr, "  %s  %s  %s\n", t.ID, loc, author)
+	}
+
+	return confirmSelection(verb, "threads", yes)
+}
+
+// confirmSelection asks before acting on a previewed selection, unless yes
+// is set. Without a terminal to ask on, it requires --yes.
+func confirmSelection(verb, noun string, yes bool) error {
+	if yes {
+		return nil
+	}
+	if !isatty.IsTerminal(os.Stdin.Fd()) && !isatty.IsCygwinTerminal(os.Stdin.Fd()) {
+		return fmt.Errorf("refusing to %s %s without confirmation; pass --yes", verb, noun)
+	}
+
+	ok, err := promptYesNo(os.Stdin, os.Stderr, "Continue?")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("aborted")
+	}
+	return nil
+}
+
+// promptYesNo asks a question answered on r, defaulting to no.
+func promptYesNo(r io.Reader, w io.Writer, question string) (bool, error) {
+	fmt.Fprintf(w, "%s [y/N] ", question)
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
+// threadStateItems describes threads selected by a filter for the result.
+func threadStateItems(threads []*api.Thread) []output.ThreadStateItem {
+	items := make([]output.ThreadStateItem, len(threads))
+	for i, t := range threads {
+		items[i] = output.ThreadStateItem{
+			ThreadID: t.ID,
+			Path:     t.Path,
+			Line:     t.Line,
+		}
+	}
+	return items
+}
+
+// lookupThread finds a thread by a reference to it or to one of its
+// comments; see api.ParseRef for the accepted forms.
+func lookupThread(client *api.Client, pr *api.PRRef, threadRef, commentRef string) (*api.Thread, error) {
+	threadRef = strings.TrimSpace(threadRef)
+	commentRef = strings.TrimSpace(commentRef)
+	if threadRef == "" && commentRef == "" {
+		return nil, fmt.Errorf("one of --thread or --comment is required")
+	}
+
+	ref := threadRef
+	if ref == "" {
+		ref = commentRef
+	}
+	t, _, err := newRefResolver(client, pr).find(ref)
+	return t, err
+}
+
+// findThread returns the thread threadRef refers to, or, when threadRef is
+// empty, the thread containing the comment commentRef refers to.
+func findThread(threads []*api.Thread, threadRef, commentRef string) *api.Thread {
+	s := threadRef
+	if s == "" {
+		s = commentRef
+	}
+	ref, err := api.ParseRef(s)
+	if err != nil {
+		return nil
+	}
+	t, _ := ref.Find(threads)
+	return t
+}
+
+// resolveThreadIDs maps --thread and --comment values to thread node IDs,
+// fetching the PR's threads at most once, and only when a value is not a
+// thread node ID.
+func resolveThreadIDs(client *api.Client, pr *api.PRRef, threads, comments []string) ([]string, error) {
+	resolver := newRefResolver(client, pr)
+
+	var ids []string
+	for _, ref := range joinRefs(threads, comments) {
+		if strings.TrimSpace(ref) == "" {
+			continue
+		}
+		id, err := resolver.threadID(ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("one of --thread or --comment is required")
+	}
+	return uniqueIDs(ids), nil
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence of each.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := ids[:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// setThreadStates resolves or unresolves each thread concurrently,
+// recording every outcome rather than stopping at the first failure.
+func setThreadStates(client *api.Client, items []output.ThreadStateItem, resolve bool) output.ThreadStateResult {
+	result := output.ThreadStateResult{
+		Resolved: resolve,
+		Items:    items,
+	}
+
+	sem := make(chan struct{}, threadStateWorkers)
+	var wg sync.WaitGroup
+	for i := range result.Items {
+		item := &result.Items[i]
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var err error
+			if resolve {
+				_, err = client.ResolveThread(item.ThreadID)
+			} else {
+				_, err = client.UnresolveThread(item.ThreadID)
+			}
+			if err != nil {
+				item.Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return result
+}
+
+// runThreadStateChange applies a resolve or unresolve to threads named by ID
+// or selected by filter and prints the outcome.
+func runThreadStateChange(cmd *cobra.Command, pr *api.PRRef, resolve bool, threadIDs, commentIDs []string, filter *threadFilter, yes bool) error {
+	verb, state := "resolve", "unresolved"
+	if !resolve {
+		verb, state = "unresolve", "resolved"
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	var items []output.ThreadStateItem
+	if filter.selecting() {
+		threads, err := selectThreads(client, pr, !resolve, filter)
+		if err != nil {
+			return err
+		}
+		if len(threads) == 0 {
+			return formatter.Format(output.NoOpResult{Message: fmt.Sprintf("No %s threads match", state)})
+		}
+		if err := confirmThreads(verb, threads, yes); err != nil {
+			return err
+		}
+		items = threadStateItems(threads)
+	} else {
+		ids, err := resolveThreadIDs(client, pr, threadIDs, commentIDs)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 1 {
+			return setSingleThreadState(client, formatter, ids[0], resolve)
+		}
+		for _, id := range ids {
+			items = append(items, output.ThreadStateItem{ThreadID: id})
+		}
+	}
+
+	result := setThreadStates(client, items, resolve)
+	if err := formatter.Format(result); err != nil {
+		return err
+	}
+
+	if failed := result.Failed(); failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d threads failed", failed, len(result.Items))
+	}
+
+	return nil
+}
+
+func setSingleThreadState(client *api.Client, formatter output.Formatter, threadID string, resolve bool) error {
+	var (
+		result *api.ResolveThreadResult
+		err    error
+	)
+	if resolve {
+		result, err = client.ResolveThread(threadID)
+	} else {
+		result, err = client.UnresolveThread(threadID)
+	}
+	if err != nil {
+		return err
+	}
+
+	return formatter.Format(output.ResolveResult{
+		ThreadID: result.ThreadID,
+		Resolved: result.IsResolved,
+	})
+}
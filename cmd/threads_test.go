@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+)
+
+func TestThreadFilterMatches(t *testing.T) {
+	thread := &api.Thread{
+		ID:    "PRRT_1",
+		Path:  "internal/db/store.go",
+		State: "changes_requested",
+		Comments: []*api.ThreadComment{
+			{ID: "PRRC_1", Author: "octocat"},
+			{ID: "PRRC_2", Author: "hubot"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter threadFilter
+		want   bool
+	}{
+		{"empty filter", threadFilter{}, true},
+		{"thread author", threadFilter{Author: "octocat"}, true},
+		{"author with at sign", threadFilter{Author: "@OctoCat"}, true},
+		{"reply author is not thread author", threadFilter{Author: "hubot"}, false},
+		{"full path glob", threadFilter{Path: "internal/db/*.go"}, true},
+		{"base name glob", threadFilter{Path: "store.go"}, true},
+		{"non-matching glob", threadFilter{Path: "cmd/*.go"}, false},
+		{"review state", threadFilter{States: []string{"changes_requested"}}, true},
+		{"other review state", threadFilter{States: []string{"approved"}}, false},
+		{"outdated only", threadFilter{OutdatedOnly: true}, false},
+		{"last comment by PR author", threadFilter{LastByPRAuthor: true, prAuthor: "hubot"}, true},
+		{"last comment by someone else", threadFilter{LastByPRAuthor: true, prAuthor: "octocat"}, false},
+		{"all criteria", threadFilter{Author: "octocat", Path: "*.go", States: []string{"changes_requested"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(thread); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterThreads(t *testing.T) {
+	threads := []*api.Thread{
+		{ID: "PRRT_1", Path: "a.go", IsResolved: true},
+		{ID: "PRRT_2", Path: "b.go", IsResolved: false},
+		{ID: "PRRT_3", Path: "c.txt", IsResolved: true},
+	}
+
+	got := filterThreads(threads, true, threadFilter{Path: "*.go"})
+	if len(got) != 1 || got[0].ID != "PRRT_1" {
+		t.Errorf("filterThreads() = %v, want only PRRT_1", got)
+	}
+}
+
+func TestThreadFilterSelecting(t *testing.T) {
+	if (threadFilter{}).selecting() {
+		t.Error("selecting() = true for an empty filter")
+	}
+	if !(threadFilter{OutdatedOnly: true}).selecting() {
+		t.Error("selecting() = false with --outdated")
+	}
+	if !(threadFilter{All: true}).selecting() {
+		t.Error("selecting() = false with --all")
+	}
+}
+
+func TestAwaitingFilterMatches(t *testing.T) {
+	waitingOnMe := &api.Thread{Comments: []*api.ThreadComment{{Author: "me"}, {Author: "octocat"}}}
+	waitingOnThem := &api.Thread{Comments: []*api.ThreadComment{{Author: "octocat"}, {Author: "me"}}}
+
+	tests := []struct {
+		name   string
+		filter awaitingFilter
+		thread *api.Thread
+		want   bool
+	}{
+		{"inactive", awaitingFilter{}, waitingOnThem, true},
+		{"awaiting me", awaitingFilter{Me: true, viewer: "me"}, waitingOnMe, true},
+		{"awaiting me, I answered", awaitingFilter{Me: true, viewer: "me"}, waitingOnThem, false},
+		{"awaiting others", awaitingFilter{Others: true, viewer: "me"}, waitingOnThem, true},
+		{"awaiting others, they answered", awaitingFilter{Others: true, viewer: "me"}, waitingOnMe, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(tt.thread); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAwaitingFlags(t *testing.T) {
+	for _, cmd := range []*cobra.Command{viewCmd, commentsCmd} {
+		for _, name := range []string{"awaiting-me", "awaiting-others"} {
+			if cmd.Flags().Lookup(name) == nil {
+				t.Errorf("%s: %s flag not registered", cmd.Name(), name)
+			}
+		}
+	}
+}
+
+func TestPromptYesNo(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"y\n", true},
+		{"YES\n", true},
+		{"n\n", false},
+		{"\n", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%q", tt.input), func(t *testing.T) {
+			var out bytes.Buffer
+			got, err := promptYesNo(strings.NewReader(tt.input), &out, "Continue?")
+			if err != nil {
+				t.Fatalf("promptYesNo() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("promptYesNo() = %v, want %v", got, tt.want)
+			}
+			if !strings.Contains(out.String(), "[y/N]") {
+				t.Errorf("prompt = %q, want a [y/N] hint", out.String())
+			}
+		})
+	}
+}
+
+func TestFindThread(t *testing.T) {
+	threads := []*api.Thread{
+		{ID: "PRRT_a", Comments: []*api.ThreadComment{{ID: "PRRC_1"}}},
+		{ID: "PRRT_b", Comments: []*api.ThreadComment{{ID: "PRRC_2"}, {ID: "PRRC_3"}}},
+	}
+
+	tests := []struct {
+		name      string
+		threadID  string
+		commentID string
+		want      string
+	}{
+		{"by thread ID", "PRRT_b", "", "PRRT_b"},
+		{"thread ID wins over comment", "PRRT_a", "PRRC_3", "PRRT_a"},
+		{"by reply comment", "", "PRRC_3", "PRRT_b"},
+		{"unknown thread", "PRRT_x", "", ""},
+		{"unknown comment", "", "PRRC_x", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findThread(threads, tt.threadID, tt.commentID)
+			switch {
+			case tt.want == "" && got != nil:
+				t.Errorf("findThread() = %s, want nil", got.ID)
+			case tt.want != "" && (got == nil || got.ID != tt.want):
+				t.Errorf("findThread() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveThreadIDs(t *testing.T) {
+	t.Run("thread IDs need no client", func(t *testing.T) {
+		got, err := resolveThreadIDs(nil, nil, []string{"PRRT_a", " PRRT_b ", "PRRT_a"}, nil)
+		if err != nil {
+			t.Fatalf("resolveThreadIDs() unexpected error: %v", err)
+		}
+		if want := []string{"PRRT_a", "PRRT_b"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("resolveThreadIDs() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("nothing given is an error", func(t *testing.T) {
+		_, err := resolveThreadIDs(nil, nil, []string{" "}, nil)
+		if err == nil {
+			t.Error("resolveThreadIDs() expected error when no IDs are given")
+		}
+	})
+}
+
+func TestThreadStateCmdFlags(t *testing.T) {
+	if unresolveCmd.Flags().Lookup("comment").Shorthand != "c" {
+		t.Error("unresolve: comment flag shorthand should be c")
+	}
+	for _, cmd := range []*cobra.Command{resolveCmd, unresolveCmd} {
+		for _, name := range append([]string{"thread", "yes"}, threadFilterFlags...) {
+			if cmd.Flags().Lookup(name) == nil {
+				t.Errorf("%s: %s flag not registered", cmd.Name(), name)
+			}
+		}
+	}
+}
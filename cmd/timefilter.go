@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// timeFilter keeps the comments created within --since and --until.
+type timeFilter struct {
+	Since string
+	Until string
+
+	// since and until are filled by prepare; zero leaves that side open.
+	// A date-only --until covers its whole day: until is then the start of
+	// the next day and excluded.
+	since    time.Time
+	until    time.Time
+	untilDay bool
+}
+
+func addTimeFlags(cmd *cobra.Command, f *timeFilter) {
+	cmd.Flags().StringVar(&f.Since, "since", "", "Show only comments made at or after a time (RFC3339, date or age like 2d)")
+	cmd.Flags().StringVar(&f.Until, "until", "", "Show only comments made at or before a time (RFC3339, date or age like 1w)")
+}
+
+// active reports whether either bound was given.
+func (f timeFilter) active() bool {
+	return f.Since != "" || f.Until != ""
+}
+
+// prepare parses the bounds, taking ages relative to now.
+func (f *timeFilter) prepare(now time.Time) error {
+	var err error
+	if f.Since != "" {
+		if f.since, err = parseTimeFlag(f.Since, now); err != nil {
+			return fmt.Errorf("--since: %w", err)
+		}
+	}
+	if f.Until != "" {
+		if f.until, err = parseTimeFlag(f.Until, now); err != nil {
+			return fmt.Errorf("--until: %w", err)
+		}
+		if isDateOnly(f.Until) {
+			f.until, f.untilDay = f.until.AddDate(0, 0, 1), true
+		}
+	}
+	if !f.since.IsZero() && !f.until.IsZero() && f.since.After(f.until) {
+		return fmt.Errorf("--since %s is after --until %s", f.Since, f.Until)
+	}
+	return nil
+}
+
+// matches reports whether a comment created at t is within the bounds.
+// Every comment matches when the filter is inactive; comments of unknown
+// time match none when it is active.
+func (f timeFilter) matches(t time.Time) bool {
+	if !f.active() {
+		return true
+	}
+	if t.IsZero() {
+		return false
+	}
+	if !f.since.IsZero() && t.Before(f.since) {
+		return false
+	}
+	if f.untilDay && !t.Before(f.until) {
+		return false
+	}
+	if !f.untilDay && !f.until.IsZero() && t.After(f.until) {
+		return false
+	}
+	return true
+}
+
+// ageUnits are the units parseTimeFlag accepts beyond time.ParseDuration.
+var ageUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+var agePattern = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// isDateOnly reports whether value is a date without a time of day.
+func isDateOnly(value string) bool {
+	_, err := time.Parse(time.DateOnly, value)
+	return err == nil
+}
+
+// parseTimeFlag reads a point in time given as RFC3339, as a local date
+// (2006-01-02), or as an age before now: "2d", "1w" or any Go duration
+// such as "36h" or "90m".
+func parseTimeFlag(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	if m := agePattern.FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid age %q: %w", value, err)
+		}
+		return now.Add(-time.Duration(n) * ageUnits[m[2]]), nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: want RFC3339, a date (2006-01-02) or an age like 2d, 1w or 12h", value)
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeFlag(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2026-03-01T09:30:00Z", time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{"2026-03-01", time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)},
+		{"2d", now.Add(-48 * time.Hour)},
+		{"1w", now.Add(-7 * 24 * time.Hour)},
+		{"36h", now.Add(-36 * time.Hour)},
+		{"90m", now.Add(-90 * time.Minute)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseTimeFlag(tt.value, now)
+			if err != nil {
+				t.Fatalf("parseTimeFlag(%q) unexpected error: %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseTimeFlag(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	for _, value := range []string{"", "yesterday", "2x", "-2h", "03/01/2026"} {
+		if _, err := parseTimeFlag(value, now); err == nil {
+			t.Errorf("parseTimeFlag(%q) expected error", value)
+		}
+	}
+}
+
+func TestTimeFilter(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	if !(timeFilter{}).matches(time.Time{}) {
+		t.Error("an inactive filter should keep every comment")
+	}
+
+	f := timeFilter{Since: "2d", Until: "1d"}
+	if err := f.prepare(now); err != nil {
+		t.Fatalf("prepare() unexpected error: %v", err)
+	}
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"before", now.Add(-72 * time.Hour), false},
+		{"since", now.Add(-48 * time.Hour), true},
+		{"within", now.Add(-36 * time.Hour), true},
+		{"until", now.Add(-24 * time.Hour), true},
+		{"after", now.Add(-time.Hour), false},
+		{"unknown", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		if got := f.matches(tt.at); got != tt.want {
+			t.Errorf("matches(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// The documented example: a date-only --until takes in the whole day.
+	dates := timeFilter{Since: "2026-03-01", Until: "2026-03-08"}
+	if err := dates.prepare(now); err != nil {
+		t.Fatalf("prepare() unexpected error: %v", err)
+	}
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, time.Local) }
+	for _, tt := range []struct {
+		at   time.Time
+		want bool
+	}{
+		{day(1, 0), true},
+		{day(8, 0), true},
+		{day(8, 23), true},
+		{day(9, 0), false},
+	} {
+		if got := dates.matches(tt.at); got != tt.want {
+			t.Errorf("--until 2026-03-08: matches(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	reversed := timeFilter{Since: "1d", Until: "2d"}
+	if err := reversed.prepare(now); err == nil {
+		t.Error("prepare() expected error when --since is after --until")
+	}
+}
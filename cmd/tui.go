@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/diff"
+	"github.com/srnnkls/gh-review/internal/tui"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui [<number>]",
+	Short: "Review a pull request interactively",
+	Long: `Walk through a review in a full-screen terminal interface.
+
+Review threads are listed at the top; the selected thread's diff hunk and
+conversation are shown side by side below. Press f to switch to the changed
+files and comment anywhere in the diff. Press ? for all key bindings.
+
+Replies and resolve/unresolve take effect immediately. New comments go into
+your pending review, which is created when needed; edit or delete its drafts
+and submit it without leaving the interface.`,
+	Example: `  gh review tui 123
+  gh review tui`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTUI,
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	if !isatty.IsTerminal(os.Stdout.Fd()) || !isatty.IsTerminal(os.Stdin.Fd()) {
+		return fmt.Errorf("tui requires an interactive terminal")
+	}
+
+	pr, err := resolvePRArgs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	return tui.Run(&clientBackend{client: client, pr: pr}, pr.String())
+}
+
+// clientBackend carries out the interface's actions on one pull request.
+type clientBackend struct {
+	client *api.Client
+	pr     *api.PRRef
+}
+
+func (b *clientBackend) Threads() ([]*api.Thread, error) {
+	result, err := b.client.ReviewThreads(b.pr, api.ReviewThreadsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return result.Threads, nil
+}
+
+func (b *clientBackend) Diff() (*diff.Diff, error) {
+	return b.client.PullRequestDiff(b.pr)
+}
+
+func (b *clientBackend) Reply(threadID, body string) error {
+	_, err := b.client.ReplyThread(api.ReplyThreadInput{ThreadID: threadID, Body: body})
+	return err
+}
+
+func (b *clientBackend) SetResolved(threadID string, resolved bool) error {
+	var err error
+	if resolved {
+		_, err = b.client.ResolveThread(threadID)
+	} else {
+		_, err = b.client.UnresolveThread(threadID)
+	}
+	return err
+}
+
+func (b *clientBackend) AddComment(anchor diff.Anchor, body string) error {
+	reviewID, err := pendingReviewID(b.client, b.pr, "")
+	if err != nil {
+		return err
+	}
+
+	input := api.AddThreadInput{
+		ReviewID: reviewID,
+		Path:     anchor.Path,
+		Line:     anchor.Line,
+		Side:     anchor.Side,
+		Body:     body,
+	}
+	if anchor.StartLine > 0 {
+		input.StartLine = &anchor.StartLine
+		input.StartSide = &anchor.StartSide
+	}
+
+	_, err = b.client.AddThread(input)
+	return err
+}
+
+func (b *clientBackend) EditComment(commentID, body string) error {
+	return b.client.UpdateComment(api.UpdateCommentInput{CommentID: commentID, Body: body})
+}
+
+func (b *clientBackend) DeleteComment(commentID string) error {
+	return b.client.DeleteComment(commentID)
+}
+
+// Submit submits the viewer's pending review. Unlike AddComment it never
+// starts one: without drafts there is nothing to submit.
+func (b *clientBackend) Submit(event, body string) error {
+	review, err := b.client.LatestPendingReview(b.pr, api.PendingReviewsOptions{})
+	if errors.Is(err, api.ErrNoPendingReview) {
+		return fmt.Errorf("nothing to submit: %w", err)
+	}
+	if err != nil {
+		return err
+	}
+	return b.client.SubmitReview(api.SubmitReviewInput{ReviewID: review.ID, Event: event, Body: body})
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var unhideCmd = &cobra.Command{
+	Use:   "unhide [<number>]",
+	Short: "Show hidden comments again",
+	Long: `Unhide (unminimize) review comments and PR discussion comments.
+
+Name comments with --comment, as for 'hide'; the flag may be repeated or take
+a comma-separated list.
+
+Alternatively, select hidden comments with the same filters as 'hide'.
+Matching comments are listed for confirmation first; pass --yes to skip the
+prompt.`,
+	Example: `  gh review unhide 123 -c c3.2
+  gh review unhide 123 -c IC_xxx,IC_yyy
+  gh review unhide 123 --author octocat
+  gh review unhide 123 --all --yes`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUnhide,
+}
+
+var (
+	unhideComments []string
+	unhideFilter   commentFilter
+	unhideYes      bool
+)
+
+func init() {
+	rootCmd.AddCommand(unhideCmd)
+	unhideCmd.Flags().StringSliceVarP(&unhideComments, "comment", "c", nil, "Comments to unhide: c3.2, comment ID, URL or node ID")
+	addCommentFilterFlags(unhideCmd, &unhideFilter, "hidden")
+	unhideCmd.Flags().BoolVarP(&unhideYes, "yes", "y", false, "Unhide filtered comments without asking")
+
+	for _, filter := range commentFilterFlags {
+		unhideCmd.MarkFlagsMutuallyExclusive("comment", filter)
+	}
+}
+
+func runUnhide(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args, unhideComments...)
+	if err != nil {
+		return err
+	}
+
+	return runCommentVisibilityChange(cmd, pr, false, "", unhideComments, &unhideFilter, unhideYes)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var unresolveCmd = &cobra.Command{
+	Use:   "unresolve [<number>]",
+	Short: "Reopen resolved review threads",
+	Long: `Mark review threads as unresolved.
+
+Identify threads by one of their comments (--comment) or directly
+(--thread), as for 'resolve'; both flags may be repeated or take a
+comma-separated list.
+
+Alternatively, select resolved threads with the same filters as 'resolve'.
+Matching threads are listed for confirmation first; pass --yes to skip the
+prompt.`,
+	Example: `  gh review unresolve 123 -c PRRC_xxx
+  gh review unresolve 123 --thread PRRT_xxx,PRRT_yyy
+  gh review unresolve 123 -c c3.1
+  gh review unresolve 123 --path 'internal/db/*.go'
+  gh review unresolve 123 --author octocat --yes`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUnresolve,
+}
+
+var (
+	unresolveComments []string
+	unresolveThreads  []string
+	unresolveFilter   threadFilter
+	unresolveYes      bool
+)
+
+func init() {
+	rootCmd.AddCommand(unresolveCmd)
+	unresolveCmd.Flags().StringSliceVarP(&unresolveComments, "comment", "c", nil, "Comments whose threads to unresolve: c3.2, comment ID, URL or node ID")
+	unresolveCmd.Flags().StringSliceVar(&unresolveThreads, "thread", nil, "Threads to unresolve: t3 or node ID")
+	addThreadFilterFlags(unresolveCmd, &unresolveFilter, "resolved")
+	unresolveCmd.Flags().BoolVarP(&unresolveYes, "yes", "y", false, "Unresolve filtered threads without asking")
+
+	for _, filter := range threadFilterFlags {
+		unresolveCmd.MarkFlagsMutuallyExclusive("comment", filter)
+		unresolveCmd.MarkFlagsMutuallyExclusive("thread", filter)
+	}
+}
+
+func runUnresolve(cmd *cobra.Command, args []string) error {
+	pr, err := resolvePRArgs(args, joinRefs(unresolveThreads, unresolveComments)...)
+	if err != nil {
+		return err
+	}
+
+	return runThreadStateChange(cmd, pr, false, unresolveThreads, unresolveComments, &unresolveFilter, unresolveYes)
+}
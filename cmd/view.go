@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/srnnkls/gh-review/internal/api"
@@ -10,16 +11,49 @@ import (
 )
 
 var viewCmd = &cobra.Command{
-	Use:   "view <number>",
+	Use:   "view [<number>]",
 	Short: "View PR review threads",
 	Long: `View review threads for a pull request.
 
-Shows threads with their comments in hierarchical structure.`,
+Shows threads with their comments in hierarchical structure. Each thread and
+comment is labelled with a short reference (t3, c3.2) that --thread and
+--comment accept in other commands.
+
+Above its comments, each thread shows the end of the diff hunk it is attached
+to, which ends at the commented line; --context sets how many lines. Outdated
+threads, whose code has since changed, are marked and located by their
+original lines. JSON output includes the full hunk.
+
+Comments hidden with 'hide' are labelled with the reason they were hidden;
+--exclude-hidden leaves them out, along with threads where every comment is
+hidden.
+
+Reaction counts follow each comment, starred when they include yours.
+--unacknowledged shows only threads the PR author has neither replied to nor
+reacted to; see 'react'.
+
+Each thread shows when its latest comment was made. --awaiting-me shows only
+unresolved threads where someone else has the last word and you took part
+or authored the PR; --awaiting-others shows those where you had the last
+word. Unsubmitted draft replies do not count as answers.
+
+--new shows only comments made since the PR was marked read with
+'mark-read'; --since-last does the same and then marks the PR read, so the
+next run shows only what came in since. As the read mark is a single point
+in time, --since-last cannot be combined with the flags that leave comments
+out, and it marks nothing when --limit truncated the threads. --since and
+--until show only comments made within a time range; see 'comments'.`,
 	Example: `  gh review view 123
   gh review view 123 --unresolved
   gh review view 123 --states=pending,changes_requested
+  gh review view 123 --context 8
+  gh review view 123 --exclude-hidden
+  gh review view 123 --unresolved --unacknowledged
+  gh review view 123 --awaiting-me
+  gh review view 123 --since-last
+  gh review view 123 --since 1w
   gh review view 123 --ids`,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.MaximumNArgs(1),
 	RunE: runView,
 }
 
@@ -28,18 +62,35 @@ var (
 	viewIDs        bool
 	viewLimit      int
 	viewStates     []string
+	viewContext    int
+	viewNoHidden   bool
+	viewUnacked    bool
+	viewAwaiting   awaitingFilter
+	viewRead       readFilter
+	viewTime       timeFilter
 )
 
 func init() {
 	rootCmd.AddCommand(viewCmd)
 	viewCmd.Flags().BoolVar(&viewUnresolved, "unresolved", false, "Show only unresolved threads")
 	viewCmd.Flags().BoolVar(&viewIDs, "ids", false, "Include thread/comment IDs in output")
-	viewCmd.Flags().IntVar(&viewLimit, "limit", 100, "Maximum threads to fetch")
+	viewCmd.Flags().IntVar(&viewLimit, "limit", 100, "Maximum threads to fetch (0 for no limit)")
 	viewCmd.Flags().StringSliceVar(&viewStates, "states", nil, "Filter by review state: pending, approved, changes_requested, commented")
+	viewCmd.Flags().IntVarP(&viewContext, "context", "C", 3, "Diff lines to show above each thread (0 to hide)")
+	viewCmd.Flags().BoolVar(&viewNoHidden, "exclude-hidden", false, "Leave out hidden (minimized) comments")
+	viewCmd.Flags().BoolVar(&viewUnacked, "unacknowledged", false, "Show only threads the PR author has not replied or reacted to")
+	addAwaitingFlags(viewCmd, &viewAwaiting)
+	addTimeFlags(viewCmd, &viewTime)
+	addReadFlags(viewCmd, &viewRead, "unresolved", "states", "exclude-hidden", "unacknowledged",
+		"awaiting-me", "awaiting-others", "since", "until")
 }
 
 func runView(cmd *cobra.Command, args []string) error {
-	pr, err := resolvePR(args[0])
+	if err := viewTime.prepare(time.Now()); err != nil {
+		return err
+	}
+
+	pr, err := resolvePRArgs(args)
 	if err != nil {
 		return err
 	}
@@ -58,26 +109,75 @@ func runView(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	var author string
+	if viewUnacked {
+		if author, err = prAuthor(client, pr); err != nil {
+			return err
+		}
+		if err := completeReactions(client, threads.Threads, nil, author); err != nil {
+			return err
+		}
+	}
+	if err := viewAwaiting.prepare(client, pr); err != nil {
+		return err
+	}
+	if err := viewRead.prepare(pr); err != nil {
+		return err
+	}
+
 	result := output.ViewResult{
 		PRRef:      pr.String(),
 		IncludeIDs: viewIDs,
+		Context:    viewContext,
 	}
 
 	for _, t := range threads.Threads {
+		if viewUnacked && threadAcknowledged(t, author) {
+			continue
+		}
+		if !viewAwaiting.matches(t) {
+			continue
+		}
+
 		thread := output.ViewThread{
-			ID:       t.ID,
-			Path:     t.Path,
-			Line:     t.Line,
-			Resolved: t.IsResolved,
+			ID:                t.ID,
+			Ref:               api.ThreadRef(t.Number),
+			Path:              t.Path,
+			Line:              t.Line,
+			StartLine:         t.StartLine,
+			OriginalLine:      t.OriginalLine,
+			OriginalStartLine: t.OriginalStartLine,
+			Outdated:          t.IsOutdated,
+			FileLevel:         t.IsFileLevel,
+			DiffHunk:          t.DiffHunk,
+			Resolved:          t.IsResolved,
+			LastActivity:      t.LastActivity(),
 		}
 
-		for _, c := range t.Comments {
+		for j, c := range t.Comments {
+			if viewNoHidden && c.IsMinimized {
+				continue
+			}
+			if !viewRead.unread(c.CreatedAt) || !viewTime.matches(c.CreatedAt) {
+				continue
+			}
+			viewRead.shown(c.ID, c.State, c.CreatedAt)
 			thread.Comments = append(thread.Comments, output.ViewThreadComment{
-				ID:     c.ID,
-				Author: c.Author,
-				Body:   c.Body,
+				ID:              c.ID,
+				Ref:             api.CommentRef(t.Number, j+1),
+				Author:          c.Author,
+				Body:            c.Body,
+				CreatedAt:       c.CreatedAt,
+				UpdatedAt:       c.UpdatedAt,
+				PublishedAt:     c.PublishedAt,
+				Minimized:       c.IsMinimized,
+				MinimizedReason: c.MinimizedReason,
+				Reactions:       outputReactions(c.Reactions),
 			})
 		}
+		if (viewNoHidden || viewRead.active() || viewTime.active()) && len(thread.Comments) == 0 {
+			continue
+		}
 
 		result.Threads = append(result.Threads, thread)
 	}
@@ -90,9 +190,12 @@ func runView(cmd *cobra.Command, args []string) error {
 	if err := formatter.Format(result); err != nil {
 		return err
 	}
+	if err := viewRead.finish(threads.Truncated); err != nil {
+		return err
+	}
 
 	if threads.Truncated {
-		fmt.Fprintf(os.Stderr, "Warning: results may be truncated. Use --limit to fetch more.\n")
+		fmt.Fprintf(os.Stderr, "Warning: results truncated at --limit=%d. Use --limit=0 to fetch all.\n", viewLimit)
 	}
 
 	return nil
@@ -0,0 +1,320 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [<number>]",
+	Short: "Stream new review activity",
+	Long: `Watch a pull request and print new activity as it happens: review
+comments and replies, discussion comments, threads being resolved or
+reopened, and reviews being submitted or dismissed. Activity from before
+the command started is not shown. Stop with Ctrl-C.
+
+The PR is polled every --interval. When a poll fails, the wait doubles up
+to --max-interval and returns to --interval once polling succeeds again.
+
+With --format=json, each event is printed as one JSON object per line
+(NDJSON). With --exec, a shell command runs for every event, with the event
+as JSON on stdin and GH_REVIEW_EVENT, GH_REVIEW_PR, GH_REVIEW_AUTHOR,
+GH_REVIEW_ID and GH_REVIEW_REF set in its environment; its output goes to
+stderr so the event stream on stdout stays intact.`,
+	Example: `  gh review watch 123
+  gh review watch 123 --interval 10s
+  gh review watch 123 --format=json | jq -r 'select(.type == "reply") | .body'
+  gh review watch 123 --exec 'notify-send "PR $GH_REVIEW_PR" "$GH_REVIEW_EVENT by $GH_REVIEW_AUTHOR"'`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWatch,
+}
+
+var (
+	watchInterval    time.Duration
+	watchMaxInterval time.Duration
+	watchExec        string
+)
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", 30*time.Second, "Time between polls")
+	watchCmd.Flags().DurationVar(&watchMaxInterval, "max-interval", 5*time.Minute, "Longest wait between polls after failures")
+	watchCmd.Flags().StringVar(&watchExec, "exec", "", "Shell command to run for every event")
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	if watchInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	if watchMaxInterval < watchInterval {
+		watchMaxInterval = watchInterval
+	}
+
+	pr, err := resolvePRArgs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.NewFormatter(outputFormat(), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	prev, err := takeWatchSnapshot(client, pr)
+	if err != nil {
+		return err
+	}
+	if outputFormat() == output.FormatTable {
+		fmt.Fprintf(os.Stderr, "Watching %s every %s; press Ctrl-C to stop.\n", pr, watchInterval)
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	wait := watchInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+
+		next, err := takeWatchSnapshot(client, pr)
+		if err != nil {
+			wait = min(wait*2, watchMaxInterval)
+			fmt.Fprintf(os.Stderr, "Warning: %v; retrying in %s\n", err, wait)
+			continue
+		}
+		wait = watchInterval
+
+		for _, event := range prev.events(next, pr.String(), time.Now()) {
+			if err := formatter.Format(event); err != nil {
+				return err
+			}
+			if watchExec != "" {
+				if err := runWatchExec(ctx, watchExec, event); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: --exec: %v\n", err)
+				}
+			}
+		}
+		prev = next
+	}
+}
+
+// watchSnapshot is what watch knows about a PR after one poll: its threads,
+// discussion comments and reviews, with the submitted comment IDs and
+// review states indexed for comparison. Drafts are left out until they are
+// submitted.
+type watchSnapshot struct {
+	threads    []*api.Thread
+	discussion []*api.PRComment
+	reviews    []*api.Review
+
+	resolved     map[string]bool
+	comments     map[string]bool
+	reviewStates map[string]string
+}
+
+func takeWatchSnapshot(client *api.Client, pr *api.PRRef) (*watchSnapshot, error) {
+	threads, err := client.ReviewThreads(pr, api.ReviewThreadsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	discussion, _, err := client.PRComments(pr, 0)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := client.Reviews(pr)
+	if err != nil {
+		return nil, err
+	}
+	return newWatchSnapshot(threads.Threads, discussion, reviews), nil
+}
+
+func newWatchSnapshot(threads []*api.Thread, discussion []*api.PRComment, reviews []*api.Review) *watchSnapshot {
+	s := &watchSnapshot{
+		threads:      threads,
+		discussion:   discussion,
+		reviews:      reviews,
+		resolved:     make(map[string]bool),
+		comments:     make(map[string]bool),
+		reviewStates: make(map[string]string),
+	}
+	for _, t := range threads {
+		s.resolved[t.ID] = t.IsResolved
+		for _, c := range t.Comments {
+			if c.State != "pending" {
+				s.comments[c.ID] = true
+			}
+		}
+	}
+	for _, c := range discussion {
+		s.comments[c.ID] = true
+	}
+	for _, r := range reviews {
+		if r.State != "pending" {
+			s.reviewStates[r.ID] = r.State
+		}
+	}
+	return s
+}
+
+// events lists what changed from s to next, oldest first. Changes GitHub
+// keeps no time for, such as resolutions, are stamped with now.
+func (s *watchSnapshot) events(next *watchSnapshot, pr string, now time.Time) []output.WatchEvent {
+	var events []output.WatchEvent
+	at := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return now
+		}
+		return t
+	}
+
+	for _, t := range next.threads {
+		ref := api.ThreadRef(t.Number)
+		for j, c := range t.Comments {
+			if c.State == "pending" || s.comments[c.ID] {
+				continue
+			}
+			kind := output.EventComment
+			if j > 0 {
+				kind = output.EventReply
+			}
+			events = append(events, output.WatchEvent{
+				Kind:       kind,
+				PRRef:      pr,
+				Time:       at(c.CreatedAt),
+				ThreadRef:  ref,
+				CommentRef: api.CommentRef(t.Number, j+1),
+				ID:         c.ID,
+				Author:     c.Author,
+				Path:       t.Path,
+				Line:       t.Line,
+				FileLevel:  t.IsFileLevel,
+				State:      c.State,
+				Body:       c.Body,
+			})
+		}
+
+		resolved, seen := s.resolved[t.ID]
+		if !seen || resolved == t.IsResolved {
+			continue
+		}
+		kind := output.EventUnresolved
+		if t.IsResolved {
+			kind = output.EventResolved
+		}
+		events = append(events, output.WatchEvent{
+			Kind:      kind,
+			PRRef:     pr,
+			Time:      now,
+			ThreadRef: ref,
+			ID:        t.ID,
+			Path:      t.Path,
+			Line:      t.Line,
+			FileLevel: t.IsFileLevel,
+		})
+	}
+
+	for _, c := range next.discussion {
+		if s.comments[c.ID] {
+			continue
+		}
+		events = append(events, output.WatchEvent{
+			Kind:   output.EventDiscussion,
+			PRRef:  pr,
+			Time:   at(c.CreatedAt),
+			ID:     c.ID,
+			Author: c.Author,
+			Body:   c.Body,
+		})
+	}
+
+	for _, r := range next.reviews {
+		prevState, seen := s.reviewStates[r.ID]
+		var kind string
+		switch {
+		case r.State == "pending":
+			continue
+		case !seen:
+			kind = output.EventReview
+		case r.State == "dismissed" && prevState != "dismissed":
+			kind = output.EventDismissed
+		default:
+			continue
+		}
+		event := output.WatchEvent{
+			Kind:   kind,
+			PRRef:  pr,
+			Time:   at(r.SubmittedAt),
+			ID:     r.ID,
+			Author: r.Author,
+			State:  r.State,
+			URL:    r.URL,
+		}
+		if kind == output.EventReview {
+			event.Body = r.Body
+		} else {
+			event.Time = now
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events
+}
+
+// runWatchExec runs command through the shell for one event, passing the
+// event as JSON on stdin and its main fields in the environment.
+func runWatchExec(ctx context.Context, command string, event output.WatchEvent) error {
+	var c *exec.Cmd
+	if runtime.GOOS == "windows" {
+		c = exec.CommandContext(ctx, "cmd", "/c", command)
+	} else {
+		c = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	ref := event.CommentRef
+	if ref == "" {
+		ref = event.ThreadRef
+	}
+	c.Env = append(os.Environ(),
+		"GH_REVIEW_EVENT="+event.Kind,
+		"GH_REVIEW_PR="+event.PRRef,
+		"GH_REVIEW_AUTHOR="+event.Author,
+		"GH_REVIEW_ID="+event.ID,
+		"GH_REVIEW_REF="+ref,
+	)
+
+	var stdin bytes.Buffer
+	formatter, err := output.NewFormatter(output.FormatJSON, &stdin)
+	if err != nil {
+		return err
+	}
+	if err := formatter.Format(event); err != nil {
+		return err
+	}
+	c.Stdin = &stdin
+	// Keep stdout for the event stream.
+	c.Stdout = os.Stderr
+	c.Stderr = os.Stderr
+	return c.Run()
+}
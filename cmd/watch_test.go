@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/output"
+)
+
+func TestWatchSnapshotEvents(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 3, 1, 9, min, 0, 0, time.UTC) }
+	now := at(59)
+
+	prev := newWatchSnapshot(
+		[]*api.Thread{
+			{ID: "PRRT_1", Number: 1, Path: "main.go", Line: 20, Comments: []*api.ThreadComment{
+				{ID: "PRRC_1", Author: "octocat", State: "commented", CreatedAt: at(1)},
+				{ID: "PRRC_2", Author: "me", State: "pending", CreatedAt: at(2)},
+			}},
+			{ID: "PRRT_2", Number: 2, Path: "db.go", Line: 5, Comments: []*api.ThreadComment{
+				{ID: "PRRC_3", Author: "octocat", State: "commented", CreatedAt: at(3)},
+			}},
+		},
+		[]*api.PRComment{{ID: "IC_1", Author: "octocat", CreatedAt: at(4)}},
+		[]*api.Review{
+			{ID: "PRR_1", Author: "octocat", State: "changes_requested", SubmittedAt: at(1)},
+			{ID: "PRR_2", Author: "me", State: "pending"},
+		},
+	)
+	next := newWatchSnapshot(
+		[]*api.Thread{
+			{ID: "PRRT_1", Number: 1, Path: "main.go", Line: 20, Comments: []*api.ThreadComment{
+				{ID: "PRRC_1", Author: "octocat", State: "commented", CreatedAt: at(1)},
+				{ID: "PRRC_2", Author: "me", State: "commented", CreatedAt: at(2)},
+				{ID: "PRRC_4", Author: "octocat", State: "commented", CreatedAt: at(12)},
+			}},
+			{ID: "PRRT_2", Number: 2, Path: "db.go", Line: 5, IsResolved: true, Comments: []*api.ThreadComment{
+				{ID: "PRRC_3", Author: "octocat", State: "commented", CreatedAt: at(3)},
+			}},
+			{ID: "PRRT_3", Number: 3, Path: "api.go", IsFileLevel: true, Comments: []*api.ThreadComment{
+				{ID: "PRRC_5", Author: "hubot", State: "commented", CreatedAt: at(11)},
+			}},
+		},
+		[]*api.PRComment{
+			{ID: "IC_1", Author: "octocat", CreatedAt: at(4)},
+			{ID: "IC_2", Author: "hubot", Body: "LGTM", CreatedAt: at(13)},
+		},
+		[]*api.Review{
+			{ID: "PRR_1", Author: "octocat", State: "dismissed", SubmittedAt: at(1)},
+			{ID: "PRR_2", Author: "me", State: "commented", SubmittedAt: at(10)},
+		},
+	)
+
+	events := prev.events(next, "o/r#1", now)
+
+	want := []struct {
+		kind string
+		id   string
+		ref  string
+	}{
+		{output.EventReply, "PRRC_2", "c1.2"},
+		{output.EventReview, "PRR_2", ""},
+		{output.EventComment, "PRRC_5", "c3.1"},
+		{output.EventReply, "PRRC_4", "c1.3"},
+		{output.EventDiscussion, "IC_2", ""},
+		{output.EventResolved, "PRRT_2", ""},
+		{output.EventDismissed, "PRR_1", ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("events() returned %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Kind != w.kind || e.ID != w.id || e.CommentRef != w.ref || e.PRRef != "o/r#1" {
+			t.Errorf("event %d = %s %s %s, want %s %s %s", i, e.Kind, e.ID, e.CommentRef, w.kind, w.id, w.ref)
+		}
+	}
+	if resolved := events[5]; resolved.ThreadRef != "t2" || !resolved.Time.Equal(now) {
+		t.Errorf("resolved event = %+v", resolved)
+	}
+}
+
+func TestWatchSnapshotEventsUnchanged(t *testing.T) {
+	threads := []*api.Thread{{ID: "PRRT_1", Number: 1, Comments: []*api.ThreadComment{{ID: "PRRC_1", State: "commented"}}}}
+	s := newWatchSnapshot(threads, nil, nil)
+
+	if events := s.events(newWatchSnapshot(threads, nil, nil), "o/r#1", time.Now()); len(events) != 0 {
+		t.Errorf("events() = %+v, want none", events)
+	}
+}
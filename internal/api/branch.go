@@ -0,0 +1,92 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BranchHead identifies the branch a pull request is opened from. Owner is
+// the login owning the head repository; it is empty when unknown.
+type BranchHead struct {
+	Owner  string
+	Branch string
+}
+
+func (h BranchHead) String() string {
+	if h.Owner == "" {
+		return h.Branch
+	}
+	return h.Owner + ":" + h.Branch
+}
+
+// BranchPR is an open pull request found for a head branch.
+type BranchPR struct {
+	Number    int
+	Title     string
+	HeadOwner string
+}
+
+// OpenPRsForHead returns the open pull requests in owner/name whose head is
+// the given branch. When head.Owner is set, only pull requests from that
+// owner's repository match, which tells forks with the same branch name
+// apart.
+func (c *Client) OpenPRsForHead(owner, name string, head BranchHead) ([]*BranchPR, error) {
+	branch := strings.TrimSpace(head.Branch)
+	if branch == "" {
+		return nil, fmt.Errorf("head branch is required")
+	}
+
+	const query = `query OpenPRsForHead($owner: String!, $name: String!, $head: String!) {
+  repository(owner: $owner, name: $name) {
+    pullRequests(headRefName: $head, states: OPEN, first: 30) {
+      nodes {
+        number
+        title
+        headRepositoryOwner { login }
+      }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"owner": owner,
+		"name":  name,
+		"head":  branch,
+	}
+
+	var response struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					Number              int    `json:"number"`
+					Title               string `json:"title"`
+					HeadRepositoryOwner *struct {
+						Login string `json:"login"`
+					} `json:"headRepositoryOwner"`
+				} `json:"nodes"`
+			} `json:"pullRequests"`
+		} `json:"repository"`
+	}
+
+	if err := c.gql.Do(query, variables, &response); err != nil {
+		return nil, fmt.Errorf("find pull requests for %s: %w", head, err)
+	}
+
+	var prs []*BranchPR
+	for _, node := range response.Repository.PullRequests.Nodes {
+		headOwner := ""
+		if node.HeadRepositoryOwner != nil {
+			headOwner = node.HeadRepositoryOwner.Login
+		}
+		if head.Owner != "" && !strings.EqualFold(headOwner, head.Owner) {
+			continue
+		}
+		prs = append(prs, &BranchPR{
+			Number:    node.Number,
+			Title:     node.Title,
+			HeadOwner: headOwner,
+		})
+	}
+
+	return prs, nil
+}
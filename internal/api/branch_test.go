@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBranchHeadString(t *testing.T) {
+	if got := (BranchHead{Branch: "fix"}).String(); got != "fix" {
+		t.Errorf("String() = %q, want %q", got, "fix")
+	}
+	if got := (BranchHead{Owner: "octocat", Branch: "fix"}).String(); got != "octocat:fix" {
+		t.Errorf("String() = %q, want %q", got, "octocat:fix")
+	}
+}
+
+func TestClientOpenPRsForHead(t *testing.T) {
+	resp := `{"repository": {"pullRequests": {"nodes": [
+		{"number": 7, "title": "Upstream fix", "headRepositoryOwner": {"login": "owner"}},
+		{"number": 9, "title": "Fork fix", "headRepositoryOwner": {"login": "OctoCat"}},
+		{"number": 11, "title": "Deleted fork", "headRepositoryOwner": null}
+	]}}}`
+
+	tests := []struct {
+		name string
+		head BranchHead
+		want []int
+	}{
+		{"any owner", BranchHead{Branch: "fix"}, []int{7, 9, 11}},
+		{"fork owner", BranchHead{Owner: "octocat", Branch: "fix"}, []int{9}},
+		{"no match", BranchHead{Owner: "hubot", Branch: "fix"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+				if variables["head"] != "fix" {
+					t.Errorf("head variable = %v, want %q", variables["head"], "fix")
+				}
+				return json.Unmarshal([]byte(resp), response)
+			})
+
+			prs, err := client.OpenPRsForHead("owner", "repo", tt.head)
+			if err != nil {
+				t.Fatalf("OpenPRsForHead() unexpected error: %v", err)
+			}
+			var got []int
+			for _, pr := range prs {
+				got = append(got, pr.Number)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("OpenPRsForHead() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("OpenPRsForHead() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	t.Run("empty branch", func(t *testing.T) {
+		client := newTestClient(nil)
+		if _, err := client.OpenPRsForHead("owner", "repo", BranchHead{Branch: " "}); err == nil {
+			t.Error("OpenPRsForHead() expected error for empty branch")
+		}
+	})
+
+	t.Run("GraphQL error", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return errors.New("query failed")
+		})
+		if _, err := client.OpenPRsForHead("owner", "repo", BranchHead{Branch: "fix"}); err == nil {
+			t.Error("OpenPRsForHead() expected error for GraphQL failure")
+		}
+	})
+}
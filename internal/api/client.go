@@ -15,8 +15,14 @@ type GraphQLClient interface {
 	Do(query string, variables map[string]interface{}, response interface{}) error
 }
 
+// RESTClient is the interface for REST operations
+type RESTClient interface {
+	Get(path string, response interface{}) error
+}
+
 type Client struct {
-	gql GraphQLClient
+	gql  GraphQLClient
+	rest RESTClient
 }
 
 func NewClient() (*Client, error) {
@@ -24,7 +30,11 @@ func NewClient() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create GraphQL client: %w", err)
 	}
-	return &Client{gql: gql}, nil
+	rest, err := api.DefaultRESTClient()
+	if err != nil {
+		return nil, fmt.Errorf("create REST client: %w", err)
+	}
+	return &Client{gql: gql, rest: rest}, nil
 }
 
 type PRRef struct {
@@ -44,22 +54,9 @@ func NewPRRef(number int, repo string) (*PRRef, error) {
 		return nil, fmt.Errorf("PR number must be positive")
 	}
 
-	var owner, name string
-
-	if repo == "" {
-		current, err := repository.Current()
-		if err != nil {
-			return nil, fmt.Errorf("could not determine repository: %w (use -R owner/repo)", err)
-		}
-		owner = current.Owner
-		name = current.Name
-	} else {
-		parsed, err := repository.Parse(repo)
-		if err != nil {
-			return nil, fmt.Errorf("invalid repository %q: %w", repo, err)
-		}
-		owner = parsed.Owner
-		name = parsed.Name
+	owner, name, err := ParseRepo(repo)
+	if err != nil {
+		return nil, err
 	}
 
 	return &PRRef{
@@ -69,11 +66,31 @@ func NewPRRef(number int, repo string) (*PRRef, error) {
 	}, nil
 }
 
+// ParseRepo splits an OWNER/REPO string, a HOST/OWNER/REPO string or a
+// repository URL into owner and name. If repo is empty, uses current
+// repository from git context.
+func ParseRepo(repo string) (owner, name string, err error) {
+	if repo == "" {
+		current, err := repository.Current()
+		if err != nil {
+			return "", "", fmt.Errorf("could not determine repository: %w (use -R owner/repo)", err)
+		}
+		return current.Owner, current.Name, nil
+	}
+
+	parsed, err := repository.Parse(repo)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid repository %q: %w", repo, err)
+	}
+	return parsed.Owner, parsed.Name, nil
+}
+
 // prURLPattern matches GitHub PR URLs: github.com/owner/repo/pull/123
 var prURLPattern = regexp.MustCompile(`(?:https?://)?(?:www\.)?github\.com/([^/]+)/([^/]+)/pull/(\d+)`)
 
 // ParsePRArg parses a PR reference from string argument.
-// Accepts: number, #number, or full GitHub URL.
+// Accepts: number, #number, or full GitHub URL, including the URL of a
+// comment on the PR.
 // Returns the PR number and optionally extracted repo info.
 func ParsePRArg(arg string) (number int, repoOverride string, err error) {
 	arg = strings.TrimSpace(arg)
@@ -99,6 +116,7 @@ func ParsePRArg(arg string) (number int, repoOverride string, err error) {
 type PRIdentity struct {
 	NodeID     string
 	HeadRefOID string
+	Author     string
 }
 
 func (c *Client) ResolvePR(pr *PRRef) (*PRIdentity, error) {
@@ -107,6 +125,7 @@ func (c *Client) ResolvePR(pr *PRRef) (*PRIdentity, error) {
     pullRequest(number: $number) {
       id
       headRefOid
+      author { login }
     }
   }
 }`
@@ -122,6 +141,9 @@ func (c *Client) ResolvePR(pr *PRRef) (*PRIdentity, error) {
 			PullRequest struct {
 				ID         string `json:"id"`
 				HeadRefOID string `json:"headRefOid"`
+				Author     struct {
+					Login string `json:"login"`
+				} `json:"author"`
 			} `json:"pullRequest"`
 		} `json:"repository"`
 	}
@@ -136,7 +158,11 @@ func (c *Client) ResolvePR(pr *PRRef) (*PRIdentity, error) {
 		return nil, fmt.Errorf("PR %s not found or missing metadata", pr)
 	}
 
-	return &PRIdentity{NodeID: nodeID, HeadRefOID: headOID}, nil
+	return &PRIdentity{
+		NodeID:     nodeID,
+		HeadRefOID: headOID,
+		Author:     strings.TrimSpace(response.Repository.PullRequest.Author.Login),
+	}, nil
 }
 
 func (c *Client) ViewerLogin() (string, error) {
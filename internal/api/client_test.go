@@ -62,6 +62,20 @@ func TestParsePRArg(t *testing.T) {
 			wantRepo:   "foo/bar",
 			wantErr:    false,
 		},
+		{
+			name:       "review comment URL",
+			arg:        "https://github.com/owner/repo/pull/7#discussion_r123456",
+			wantNumber: 7,
+			wantRepo:   "owner/repo",
+			wantErr:    false,
+		},
+		{
+			name:       "files view comment URL",
+			arg:        "https://github.com/owner/repo/pull/7/files#r123456",
+			wantNumber: 7,
+			wantRepo:   "owner/repo",
+			wantErr:    false,
+		},
 		{
 			name:        "zero number",
 			arg:         "0",
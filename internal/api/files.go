@@ -0,0 +1,44 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/srnnkls/gh-review/internal/diff"
+)
+
+// filesPerPage is the largest page the pull request files endpoint serves.
+const filesPerPage = 100
+
+// PullRequestDiff fetches the changed files of a PR with their parsed
+// patches. GitHub lists at most 3000 files per PR.
+func (c *Client) PullRequestDiff(pr *PRRef) (*diff.Diff, error) {
+	result := &diff.Diff{}
+
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("repos/%s/%s/pulls/%d/files?per_page=%d&page=%d",
+			pr.Owner, pr.Repo, pr.Number, filesPerPage, page)
+
+		var response []struct {
+			Filename         string `json:"filename"`
+			PreviousFilename string `json:"previous_filename"`
+			Status           string `json:"status"`
+			Patch            string `json:"patch"`
+		}
+
+		if err := c.rest.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("list PR files: %w", err)
+		}
+
+		for _, f := range response {
+			file, err := diff.NewFile(f.Filename, f.PreviousFilename, f.Status, f.Patch)
+			if err != nil {
+				return nil, err
+			}
+			result.Files = append(result.Files, file)
+		}
+
+		if len(response) < filesPerPage {
+			return result, nil
+		}
+	}
+}
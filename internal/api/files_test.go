@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// mockRESTClient implements RESTClient interface for testing
+type mockRESTClient struct {
+	GetFunc func(path string, response interface{}) error
+}
+
+func (m *mockRESTClient) Get(path string, response interface{}) error {
+	if m.GetFunc != nil {
+		return m.GetFunc(path, response)
+	}
+	return nil
+}
+
+func TestClientPullRequestDiff(t *testing.T) {
+	t.Run("parses files across pages", func(t *testing.T) {
+		var paths []string
+		client := &Client{rest: &mockRESTClient{GetFunc: func(path string, response interface{}) error {
+			paths = append(paths, path)
+			if strings.HasSuffix(path, "page=1") {
+				files := make([]string, filesPerPage)
+				for i := range files {
+					files[i] = fmt.Sprintf(`{"filename": "f%d.go", "status": "modified", "patch": "@@ -1 +1 @@\n-a\n+b"}`, i)
+				}
+				return json.Unmarshal([]byte("["+strings.Join(files, ",")+"]"), response)
+			}
+			return json.Unmarshal([]byte(`[{"filename": "logo.png", "status": "added"}]`), response)
+		}}}
+
+		pr := &PRRef{Owner: "o", Repo: "r", Number: 7}
+		d, err := client.PullRequestDiff(pr)
+		if err != nil {
+			t.Fatalf("PullRequestDiff() unexpected error: %v", err)
+		}
+		if len(paths) != 2 || paths[0] != "repos/o/r/pulls/7/files?per_page=100&page=1" {
+			t.Errorf("requested paths = %v", paths)
+		}
+		if len(d.Files) != filesPerPage+1 {
+			t.Fatalf("Files length = %d, want %d", len(d.Files), filesPerPage+1)
+		}
+		if f := d.File("f0.go"); f == nil || len(f.Hunks) != 1 {
+			t.Error("expected f0.go with one parsed hunk")
+		}
+		if f := d.File("logo.png"); f == nil || f.HasPatch {
+			t.Error("expected logo.png without a patch")
+		}
+	})
+
+	t.Run("REST error", func(t *testing.T) {
+		client := &Client{rest: &mockRESTClient{GetFunc: func(path string, response interface{}) error {
+			return errors.New("not found")
+		}}}
+
+		_, err := client.PullRequestDiff(&PRRef{Owner: "o", Repo: "r", Number: 1})
+		if err == nil {
+			t.Error("PullRequestDiff() expected error for REST failure")
+		}
+	})
+}
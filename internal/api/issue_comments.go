@@ -0,0 +1,213 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsIssueCommentID reports whether commentID is the node ID of a PR
+// discussion comment (IC_…) rather than a review comment (PRRC_…).
+func IsIssueCommentID(commentID string) bool {
+	return strings.HasPrefix(strings.TrimSpace(commentID), "IC_")
+}
+
+func checkIssueCommentID(commentID string) (string, error) {
+	commentID = strings.TrimSpace(commentID)
+	if commentID == "" {
+		return "", fmt.Errorf("comment ID required")
+	}
+	if !IsIssueCommentID(commentID) {
+		return "", fmt.Errorf("invalid comment ID %q: expected a PR comment node ID", commentID)
+	}
+	return commentID, nil
+}
+
+// AddCommentInput describes a top-level comment on a PR's conversation.
+type AddCommentInput struct {
+	PRNodeID string
+	Body     string
+}
+
+type AddCommentResult struct {
+	ID  string
+	URL string
+}
+
+// AddComment posts a discussion comment on a PR, outside any review.
+func (c *Client) AddComment(input AddCommentInput) (*AddCommentResult, error) {
+	prNodeID := strings.TrimSpace(input.PRNodeID)
+	if prNodeID == "" {
+		return nil, fmt.Errorf("PR node ID required")
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, fmt.Errorf("body required")
+	}
+
+	const mutation = `mutation AddComment($input: AddCommentInput!) {
+  addComment(input: $input) {
+    commentEdge {
+      node {
+        id
+        url
+      }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"subjectId": prNodeID,
+			"body":      body,
+		},
+	}
+
+	var response struct {
+		AddComment struct {
+			CommentEdge struct {
+				Node struct {
+					ID  string `json:"id"`
+					URL string `json:"url"`
+				} `json:"node"`
+			} `json:"commentEdge"`
+		} `json:"addComment"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return nil, fmt.Errorf("add comment: %w", err)
+	}
+
+	node := response.AddComment.CommentEdge.Node
+	id := strings.TrimSpace(node.ID)
+	if id == "" {
+		return nil, fmt.Errorf("add comment returned empty comment ID")
+	}
+
+	return &AddCommentResult{
+		ID:  id,
+		URL: node.URL,
+	}, nil
+}
+
+// UpdateIssueComment replaces the body of a PR discussion comment.
+func (c *Client) UpdateIssueComment(input UpdateCommentInput) error {
+	commentID, err := checkIssueCommentID(input.CommentID)
+	if err != nil {
+		return err
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return fmt.Errorf("body required")
+	}
+
+	const mutation = `mutation UpdateIssueComment($input: UpdateIssueCommentInput!) {
+  updateIssueComment(input: $input) {
+    issueComment {
+      id
+    }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id":   commentID,
+			"body": body,
+		},
+	}
+
+	var response struct {
+		UpdateIssueComment struct {
+			IssueComment struct {
+				ID string `json:"id"`
+			} `json:"issueComment"`
+		} `json:"updateIssueComment"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return fmt.Errorf("update comment: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteIssueComment deletes a PR discussion comment.
+func (c *Client) DeleteIssueComment(commentID string) error {
+	commentID, err := checkIssueCommentID(commentID)
+	if err != nil {
+		return err
+	}
+
+	const mutation = `mutation DeleteIssueComment($input: DeleteIssueCommentInput!) {
+  deleteIssueComment(input: $input) {
+    clientMutationId
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": commentID,
+		},
+	}
+
+	var response struct {
+		DeleteIssueComment struct {
+			ClientMutationID *string `json:"clientMutationId"`
+		} `json:"deleteIssueComment"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return fmt.Errorf("delete comment: %w", err)
+	}
+
+	return nil
+}
+
+// PRCommentByID fetches a single PR discussion comment.
+func (c *Client) PRCommentByID(commentID string) (*PRComment, error) {
+	commentID, err := checkIssueCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	const query = `query IssueComment($id: ID!) {
+  node(id: $id) {
+    ... on IssueComment {
+      id
+      body
+      author { login }
+      isMinimized
+      minimizedReason
+    }
+  }
+}`
+
+	var response struct {
+		Node *struct {
+			ID     string `json:"id"`
+			Body   string `json:"body"`
+			Author struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			minimizable
+		} `json:"node"`
+	}
+
+	variables := map[string]interface{}{"id": commentID}
+	if err := c.gql.Do(query, variables, &response); err != nil {
+		return nil, fmt.Errorf("query PR comment: %w", err)
+	}
+	if response.Node == nil || strings.TrimSpace(response.Node.ID) == "" {
+		return nil, fmt.Errorf("PR comment %s not found", commentID)
+	}
+
+	return &PRComment{
+		ID:     response.Node.ID,
+		Body:   response.Node.Body,
+		Author: strings.TrimSpace(response.Node.Author.Login),
+
+		IsMinimized:     response.Node.IsMinimized,
+		MinimizedReason: normalizeMinimizedReason(response.Node.MinimizedReason),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestIsIssueCommentID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"IC_kwDOABC", true},
+		{" IC_kwDOABC ", true},
+		{"PRRC_kwDOABC", false},
+		{"123", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsIssueCommentID(tt.id); got != tt.want {
+			t.Errorf("IsIssueCommentID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestClientAddComment(t *testing.T) {
+	t.Run("successful comment", func(t *testing.T) {
+		var input map[string]interface{}
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			input = variables["input"].(map[string]interface{})
+			resp := `{"addComment": {"commentEdge": {"node": {"id": "IC_1", "url": "https://github.com/o/r/pull/1#issuecomment-1"}}}}`
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		result, err := client.AddComment(AddCommentInput{PRNodeID: "PR_1", Body: " Thanks! "})
+		if err != nil {
+			t.Fatalf("AddComment() unexpected error: %v", err)
+		}
+		if result.ID != "IC_1" || result.URL == "" {
+			t.Errorf("result = %+v, want IC_1 with URL", result)
+		}
+		if input["subjectId"] != "PR_1" || input["body"] != "Thanks!" {
+			t.Errorf("input = %v", input)
+		}
+	})
+
+	t.Run("empty PR node ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		if _, err := client.AddComment(AddCommentInput{Body: "hi"}); err == nil {
+			t.Error("AddComment() expected error for empty PR node ID")
+		}
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		client := newTestClient(nil)
+		if _, err := client.AddComment(AddCommentInput{PRNodeID: "PR_1", Body: "  "}); err == nil {
+			t.Error("AddComment() expected error for empty body")
+		}
+	})
+
+	t.Run("GraphQL error", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return errors.New("locked")
+		})
+		if _, err := client.AddComment(AddCommentInput{PRNodeID: "PR_1", Body: "hi"}); err == nil {
+			t.Error("AddComment() expected error for GraphQL failure")
+		}
+	})
+}
+
+func TestClientUpdateIssueComment(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		var input map[string]interface{}
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			input = variables["input"].(map[string]interface{})
+			return json.Unmarshal([]byte(`{"updateIssueComment": {"issueComment": {"id": "IC_1"}}}`), response)
+		})
+
+		if err := client.UpdateIssueComment(UpdateCommentInput{CommentID: "IC_1", Body: "Updated"}); err != nil {
+			t.Fatalf("UpdateIssueComment() unexpected error: %v", err)
+		}
+		if input["id"] != "IC_1" || input["body"] != "Updated" {
+			t.Errorf("input = %v", input)
+		}
+	})
+
+	t.Run("review comment ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.UpdateIssueComment(UpdateCommentInput{CommentID: "PRRC_1", Body: "Updated"}); err == nil {
+			t.Error("UpdateIssueComment() expected error for a review comment ID")
+		}
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.UpdateIssueComment(UpdateCommentInput{CommentID: "IC_1"}); err == nil {
+			t.Error("UpdateIssueComment() expected error for empty body")
+		}
+	})
+}
+
+func TestClientDeleteIssueComment(t *testing.T) {
+	t.Run("successful delete", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"deleteIssueComment": {"clientMutationId": null}}`), response)
+		})
+
+		if err := client.DeleteIssueComment("IC_1"); err != nil {
+			t.Fatalf("DeleteIssueComment() unexpected error: %v", err)
+		}
+	})
+
+	t.Run("empty comment ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.DeleteIssueComment(""); err == nil {
+			t.Error("DeleteIssueComment() expected error for empty comment ID")
+		}
+	})
+
+	t.Run("review comment ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.DeleteIssueComment("PRRC_1"); err == nil {
+			t.Error("DeleteIssueComment() expected error for a review comment ID")
+		}
+	})
+}
+
+func TestClientPRCommentByID(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			resp := `{"node": {"id": "IC_1", "body": "Ship it", "author": {"login": "octocat"}, "isMinimized": true, "minimizedReason": "OFF_TOPIC"}}`
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		c, err := client.PRCommentByID("IC_1")
+		if err != nil {
+			t.Fatalf("PRCommentByID() unexpected error: %v", err)
+		}
+		if c.Body != "Ship it" || c.Author != "octocat" || !c.IsMinimized || c.MinimizedReason != "off-topic" {
+			t.Errorf("comment = %+v", c)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"node": null}`), response)
+		})
+		if _, err := client.PRCommentByID("IC_1"); err == nil {
+			t.Error("PRCommentByID() expected error for missing comment")
+		}
+	})
+}
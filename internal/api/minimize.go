@@ -0,0 +1,121 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MinimizeReasons lists the reasons a comment can be hidden for, in the
+// form ParseMinimizeReason accepts and minimized comments report.
+var MinimizeReasons = []string{"outdated", "resolved", "off-topic", "duplicate", "spam", "abuse"}
+
+// ParseMinimizeReason maps a reason such as "off-topic" or "OFF_TOPIC" to
+// the GraphQL ReportedContentClassifiers value.
+func ParseMinimizeReason(reason string) (string, error) {
+	normalized := normalizeMinimizedReason(reason)
+	for _, r := range MinimizeReasons {
+		if r == normalized {
+			return strings.ToUpper(strings.ReplaceAll(r, "-", "_")), nil
+		}
+	}
+	return "", fmt.Errorf("invalid reason %q: expected one of %s", reason, strings.Join(MinimizeReasons, ", "))
+}
+
+// normalizeMinimizedReason lowercases a reason and spells it with dashes,
+// as GitHub reports it on minimized comments.
+func normalizeMinimizedReason(reason string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(reason), "_", "-"))
+}
+
+// checkCommentID accepts the node IDs of review comments and PR discussion
+// comments, the comments that can be hidden or reacted to.
+func checkCommentID(commentID string) (string, error) {
+	commentID = strings.TrimSpace(commentID)
+	if commentID == "" {
+		return "", fmt.Errorf("comment ID required")
+	}
+	if !strings.HasPrefix(commentID, "PRRC_") && !strings.HasPrefix(commentID, "IC_") {
+		return "", fmt.Errorf("invalid comment ID %q: expected a review or PR comment node ID", commentID)
+	}
+	return commentID, nil
+}
+
+// MinimizeComment hides a review or PR discussion comment for the given
+// reason; see MinimizeReasons.
+func (c *Client) MinimizeComment(commentID, reason string) error {
+	commentID, err := checkCommentID(commentID)
+	if err != nil {
+		return err
+	}
+	classifier, err := ParseMinimizeReason(reason)
+	if err != nil {
+		return err
+	}
+
+	const mutation = `mutation MinimizeComment($input: MinimizeCommentInput!) {
+  minimizeComment(input: $input) {
+    minimizedComment { isMinimized }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"subjectId":  commentID,
+			"classifier": classifier,
+		},
+	}
+
+	var response struct {
+		MinimizeComment struct {
+			MinimizedComment struct {
+				IsMinimized bool `json:"isMinimized"`
+			} `json:"minimizedComment"`
+		} `json:"minimizeComment"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return fmt.Errorf("hide comment: %w", err)
+	}
+	if !response.MinimizeComment.MinimizedComment.IsMinimized {
+		return fmt.Errorf("hide comment: %s is still shown", commentID)
+	}
+
+	return nil
+}
+
+// UnminimizeComment shows a hidden comment again.
+func (c *Client) UnminimizeComment(commentID string) error {
+	commentID, err := checkCommentID(commentID)
+	if err != nil {
+		return err
+	}
+
+	const mutation = `mutation UnminimizeComment($input: UnminimizeCommentInput!) {
+  unminimizeComment(input: $input) {
+    unminimizedComment { isMinimized }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"subjectId": commentID,
+		},
+	}
+
+	var response struct {
+		UnminimizeComment struct {
+			UnminimizedComment struct {
+				IsMinimized bool `json:"isMinimized"`
+			} `json:"unminimizedComment"`
+		} `json:"unminimizeComment"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return fmt.Errorf("unhide comment: %w", err)
+	}
+	if response.UnminimizeComment.UnminimizedComment.IsMinimized {
+		return fmt.Errorf("unhide comment: %s is still hidden", commentID)
+	}
+
+	return nil
+}
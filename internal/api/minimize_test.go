@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseMinimizeReason(t *testing.T) {
+	tests := []struct {
+		reason  string
+		want    string
+		wantErr bool
+	}{
+		{"outdated", "OUTDATED", false},
+		{"off-topic", "OFF_TOPIC", false},
+		{"OFF_TOPIC", "OFF_TOPIC", false},
+		{" Duplicate ", "DUPLICATE", false},
+		{"rude", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			got, err := ParseMinimizeReason(tt.reason)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMinimizeReason(%q) error = %v, wantErr %v", tt.reason, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMinimizeReason(%q) = %q, want %q", tt.reason, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientMinimizeComment(t *testing.T) {
+	t.Run("review comment", func(t *testing.T) {
+		var input map[string]interface{}
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			input = variables["input"].(map[string]interface{})
+			return json.Unmarshal([]byte(`{"minimizeComment": {"minimizedComment": {"isMinimized": true}}}`), response)
+		})
+
+		if err := client.MinimizeComment("PRRC_1", "off-topic"); err != nil {
+			t.Fatalf("MinimizeComment() unexpected error: %v", err)
+		}
+		if input["subjectId"] != "PRRC_1" || input["classifier"] != "OFF_TOPIC" {
+			t.Errorf("input = %v, want PRRC_1 classified OFF_TOPIC", input)
+		}
+	})
+
+	t.Run("discussion comment", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"minimizeComment": {"minimizedComment": {"isMinimized": true}}}`), response)
+		})
+
+		if err := client.MinimizeComment("IC_1", "resolved"); err != nil {
+			t.Fatalf("MinimizeComment() unexpected error: %v", err)
+		}
+	})
+
+	t.Run("thread ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.MinimizeComment("PRRT_1", "outdated"); err == nil {
+			t.Error("MinimizeComment() expected error for a thread node ID")
+		}
+	})
+
+	t.Run("invalid reason", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.MinimizeComment("PRRC_1", "boring"); err == nil {
+			t.Error("MinimizeComment() expected error for an unknown reason")
+		}
+	})
+
+	t.Run("GraphQL error", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return errors.New("forbidden")
+		})
+		if err := client.MinimizeComment("PRRC_1", "spam"); err == nil {
+			t.Error("MinimizeComment() expected error for GraphQL failure")
+		}
+	})
+}
+
+func TestClientUnminimizeComment(t *testing.T) {
+	t.Run("shown again", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"unminimizeComment": {"unminimizedComment": {"isMinimized": false}}}`), response)
+		})
+		if err := client.UnminimizeComment("IC_1"); err != nil {
+			t.Fatalf("UnminimizeComment() unexpected error: %v", err)
+		}
+	})
+
+	t.Run("still hidden", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"unminimizeComment": {"unminimizedComment": {"isMinimized": true}}}`), response)
+		})
+		if err := client.UnminimizeComment("PRRC_1"); err == nil {
+			t.Error("UnminimizeComment() expected error when the comment stays hidden")
+		}
+	})
+}
@@ -67,6 +67,8 @@ func (c *Client) CreateReview(input CreateReviewInput) (*CreateReviewResult, err
 	}, nil
 }
 
+// AddThreadInput describes a new review thread. FileLevel threads comment
+// on the whole file and take no line, side or start line.
 type AddThreadInput struct {
 	ReviewID  string
 	Path      string
@@ -75,6 +77,7 @@ type AddThreadInput struct {
 	Body      string
 	StartLine *int
 	StartSide *string
+	FileLevel bool
 }
 
 type AddThreadResult struct {
@@ -97,7 +100,11 @@ func (c *Client) AddThread(input AddThreadInput) (*AddThreadResult, error) {
 	if path == "" {
 		return nil, fmt.Errorf("path required")
 	}
-	if input.Line <= 0 {
+	if input.FileLevel {
+		if input.Line != 0 || input.StartLine != nil || input.StartSide != nil {
+			return nil, fmt.Errorf("file-level comments take no line")
+		}
+	} else if input.Line <= 0 {
 		return nil, fmt.Errorf("line must be positive")
 	}
 
@@ -125,10 +132,14 @@ func (c *Client) AddThread(input AddThreadInput) (*AddThreadResult, error) {
 	mutationInput := map[string]interface{}{
 		"pullRequestReviewId": reviewID,
 		"path":                path,
-		"line":                input.Line,
-		"side":                side,
 		"body":                body,
 	}
+	if input.FileLevel {
+		mutationInput["subjectType"] = "FILE"
+	} else {
+		mutationInput["line"] = input.Line
+		mutationInput["side"] = side
+	}
 	if input.StartLine != nil {
 		mutationInput["startLine"] = *input.StartLine
 	}
@@ -347,8 +358,12 @@ func (c *Client) DeleteReview(reviewID string) error {
 	return nil
 }
 
+// ReplyThreadInput describes a reply. With ReviewID set, the reply is added
+// to that pending review and published when it is submitted; otherwise it
+// is posted immediately.
 type ReplyThreadInput struct {
 	ThreadID string
+	ReviewID string
 	Body     string
 }
 
@@ -371,6 +386,11 @@ func (c *Client) ReplyThread(input ReplyThreadInput) (*ReplyThreadResult, error)
 		return nil, fmt.Errorf("body required")
 	}
 
+	reviewID := strings.TrimSpace(input.ReviewID)
+	if reviewID != "" && !strings.HasPrefix(reviewID, "PRR_") {
+		return nil, fmt.Errorf("invalid review ID %q: expected GraphQL node ID", reviewID)
+	}
+
 	const mutation = `mutation ReplyThread($input: AddPullRequestReviewThreadReplyInput!) {
   addPullRequestReviewThreadReply(input: $input) {
     comment {
@@ -380,12 +400,14 @@ func (c *Client) ReplyThread(input ReplyThreadInput) (*ReplyThreadResult, error)
   }
 }`
 
-	variables := map[string]interface{}{
-		"input": map[string]interface{}{
-			"pullRequestReviewThreadId": threadID,
-			"body":                      body,
-		},
+	replyInput := map[string]interface{}{
+		"pullRequestReviewThreadId": threadID,
+		"body":                      body,
 	}
+	if reviewID != "" {
+		replyInput["pullRequestReviewId"] = reviewID
+	}
+	variables := map[string]interface{}{"input": replyInput}
 
 	var response struct {
 		AddPullRequestReviewThreadReply struct {
@@ -458,3 +480,46 @@ func (c *Client) ResolveThread(threadID string) (*ResolveThreadResult, error) {
 		IsResolved: response.ResolveReviewThread.Thread.IsResolved,
 	}, nil
 }
+
+func (c *Client) UnresolveThread(threadID string) (*ResolveThreadResult, error) {
+	threadID = strings.TrimSpace(threadID)
+	if threadID == "" {
+		return nil, fmt.Errorf("thread ID required")
+	}
+	if !strings.HasPrefix(threadID, "PRRT_") {
+		return nil, fmt.Errorf("invalid thread ID %q: expected GraphQL thread node ID", threadID)
+	}
+
+	const mutation = `mutation UnresolveThread($input: UnresolveReviewThreadInput!) {
+  unresolveReviewThread(input: $input) {
+    thread {
+      id
+      isResolved
+    }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"threadId": threadID,
+		},
+	}
+
+	var response struct {
+		UnresolveReviewThread struct {
+			Thread struct {
+				ID         string `json:"id"`
+				IsResolved bool   `json:"isResolved"`
+			} `json:"thread"`
+		} `json:"unresolveReviewThread"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return nil, fmt.Errorf("unresolve thread: %w", err)
+	}
+
+	return &ResolveThreadResult{
+		ThreadID:   strings.TrimSpace(response.UnresolveReviewThread.Thread.ID),
+		IsResolved: response.UnresolveReviewThread.Thread.IsResolved,
+	}, nil
+}
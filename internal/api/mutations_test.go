@@ -121,6 +121,52 @@ func TestClientAddThread(t *testing.T) {
 		}
 	})
 
+	t.Run("file-level thread", func(t *testing.T) {
+		var input map[string]interface{}
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			input = variables["input"].(map[string]interface{})
+			return json.Unmarshal([]byte(`{"addPullRequestReviewThread": {"thread": {"id": "PRRT_new", "path": "logo.png", "line": null}}}`), response)
+		})
+
+		result, err := client.AddThread(AddThreadInput{
+			ReviewID:  "PRR_123",
+			Path:      "logo.png",
+			Body:      "Can this be an SVG?",
+			FileLevel: true,
+		})
+		if err != nil {
+			t.Fatalf("AddThread() unexpected error: %v", err)
+		}
+		if input["subjectType"] != "FILE" {
+			t.Errorf("subjectType = %v, want FILE", input["subjectType"])
+		}
+		for _, key := range []string{"line", "side"} {
+			if _, ok := input[key]; ok {
+				t.Errorf("input has %q, want it omitted for file-level threads", key)
+			}
+		}
+		if result.Line != 0 {
+			t.Errorf("Line = %d, want 0", result.Line)
+		}
+	})
+
+	t.Run("file-level thread with line", func(t *testing.T) {
+		client := newTestClient(nil)
+		_, err := client.AddThread(AddThreadInput{ReviewID: "PRR_123", Path: "a.go", Line: 3, Body: "x", FileLevel: true})
+		if err == nil {
+			t.Error("AddThread() expected error for a file-level thread with a line")
+		}
+	})
+
+	t.Run("file-level thread with start side", func(t *testing.T) {
+		client := newTestClient(nil)
+		side := "LEFT"
+		_, err := client.AddThread(AddThreadInput{ReviewID: "PRR_123", Path: "a.go", Body: "x", FileLevel: true, StartSide: &side})
+		if err == nil {
+			t.Error("AddThread() expected error for a file-level thread with a start side")
+		}
+	})
+
 	t.Run("empty review ID", func(t *testing.T) {
 		client := newTestClient(nil)
 		_, err := client.AddThread(AddThreadInput{
@@ -1,11 +1,49 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 )
 
+// pageSize is the largest page GitHub serves for a single connection.
+const pageSize = 100
+
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// next reports whether another page follows and returns its cursor.
+func (p pageInfo) next() (string, bool) {
+	if !p.HasNextPage || p.EndCursor == "" {
+		return "", false
+	}
+	return p.EndCursor, true
+}
+
+// cursorVar converts a cursor into a GraphQL variable, using null for the
+// first page.
+func cursorVar(cursor string) interface{} {
+	if cursor == "" {
+		return nil
+	}
+	return cursor
+}
+
+// pageFirst returns the page size to request when at most remaining items
+// are still wanted. A non-positive limit means no cap.
+func pageFirst(limit, fetched int) int {
+	if limit <= 0 {
+		return pageSize
+	}
+	if remaining := limit - fetched; remaining < pageSize {
+		return remaining
+	}
+	return pageSize
+}
+
 type PendingReview struct {
 	ID         string
 	State      string
@@ -27,6 +65,21 @@ type ReviewComment struct {
 	StartSide *string
 	Outdated  bool
 	Author    string
+	DiffHunk  string
+	FileLevel bool
+	// IsMinimized comments are hidden, for MinimizedReason; see
+	// MinimizeReasons.
+	IsMinimized     bool
+	MinimizedReason string
+	// ReplyToID is the node ID of the comment this one replies to, empty for
+	// the comment that started its thread.
+	ReplyToID string
+	Reactions []Reaction
+	// CreatedAt, UpdatedAt and PublishedAt are zero when the query did not
+	// ask for them; PublishedAt is also zero for drafts.
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt time.Time
 }
 
 type PRComment struct {
@@ -34,6 +87,11 @@ type PRComment struct {
 	Body      string
 	Author    string
 	CreatedAt time.Time
+	// IsMinimized comments are hidden, for MinimizedReason; see
+	// MinimizeReasons.
+	IsMinimized     bool
+	MinimizedReason string
+	Reactions       []Reaction
 }
 
 type AllCommentsResult struct {
@@ -47,11 +105,189 @@ type ReviewCommentWithState struct {
 	State string
 }
 
+type reviewCommentNode struct {
+	ID           string `json:"id"`
+	Path         string `json:"path"`
+	Line         *int   `json:"line"`
+	StartLine    *int   `json:"startLine"`
+	Body         string `json:"body"`
+	Outdated     bool   `json:"outdated"`
+	OriginalLine *int   `json:"originalLine"`
+	DiffHunk     string `json:"diffHunk"`
+	SubjectType  string `json:"subjectType"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
+	PublishedAt  string `json:"publishedAt"`
+	minimizable
+	reactable
+	ReplyTo *struct {
+		ID string `json:"id"`
+	} `json:"replyTo"`
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// minimizable holds the hidden state shared by every kind of comment.
+type minimizable struct {
+	IsMinimized     bool   `json:"isMinimized"`
+	MinimizedReason string `json:"minimizedReason"`
+}
+
+type reviewCommentConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   pageInfo            `json:"pageInfo"`
+	Nodes      []reviewCommentNode `json:"nodes"`
+}
+
+func (n reviewCommentNode) toReviewComment() *ReviewComment {
+	line := 0
+	if n.Line != nil {
+		line = *n.Line
+	} else if n.OriginalLine != nil {
+		line = *n.OriginalLine
+	}
+
+	replyTo := ""
+	if n.ReplyTo != nil {
+		replyTo = strings.TrimSpace(n.ReplyTo.ID)
+	}
+
+	return &ReviewComment{
+		ID:          strings.TrimSpace(n.ID),
+		Path:        n.Path,
+		Line:        line,
+		StartLine:   n.StartLine,
+		Body:        n.Body,
+		Outdated:    n.Outdated,
+		Author:      strings.TrimSpace(n.Author.Login),
+		DiffHunk:    n.DiffHunk,
+		FileLevel:   n.SubjectType == "FILE",
+		ReplyToID:   replyTo,
+		CreatedAt:   parseTime(n.CreatedAt),
+		UpdatedAt:   parseTime(n.UpdatedAt),
+		PublishedAt: parseTime(n.PublishedAt),
+
+		IsMinimized:     n.IsMinimized,
+		MinimizedReason: normalizeMinimizedReason(n.MinimizedReason),
+		Reactions:       n.reactions(),
+	}
+}
+
+// ReviewCommentByID fetches a single review comment, including the diff hunk
+// it was made on.
+func (c *Client) ReviewCommentByID(commentID string) (*ReviewComment, error) {
+	commentID = strings.TrimSpace(commentID)
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID required")
+	}
+	if !strings.HasPrefix(commentID, "PRRC_") {
+		return nil, fmt.Errorf("invalid comment ID %q: expected GraphQL node ID", commentID)
+	}
+
+	const query = `query ReviewComment($id: ID!) {
+  node(id: $id) {
+    ... on PullRequestReviewComment {
+      id
+      path
+      line
+      startLine
+      originalLine
+      body
+      outdated
+      isMinimized
+      minimizedReason
+      diffHunk
+      createdAt
+      updatedAt
+      publishedAt
+      author { login }
+    }
+  }
+}`
+
+	var response struct {
+		Node *reviewCommentNode `json:"node"`
+	}
+
+	variables := map[string]interface{}{"id": commentID}
+	if err := c.gql.Do(query, variables, &response); err != nil {
+		return nil, fmt.Errorf("query review comment: %w", err)
+	}
+	if response.Node == nil || strings.TrimSpace(response.Node.ID) == "" {
+		return nil, fmt.Errorf("review comment %s not found", commentID)
+	}
+
+	return response.Node.toReviewComment(), nil
+}
+
+// reviewCommentFields selects a review comment as decoded into
+// reviewCommentNode. Every page of review comments uses it, so comments past
+// the first page carry the same fields, reactions included.
+const reviewCommentFields = `
+  id
+  path
+  line
+  startLine
+  body
+  outdated
+  isMinimized
+  minimizedReason
+  reactionGroups { content viewerHasReacted reactors(first: 20) { totalCount nodes { ... on Actor { login } } } }
+  originalLine
+  subjectType
+  createdAt
+  updatedAt
+  publishedAt
+  replyTo { id }
+  author { login }
+`
+
+// remainingReviewComments pages through a review's comments once the first
+// page, embedded in the parent query, reported more.
+func (c *Client) remainingReviewComments(reviewID string, conn reviewCommentConnection) ([]reviewCommentNode, error) {
+	const query = `query ReviewComments($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on PullRequestReview {
+      comments(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {` + reviewCommentFields + `}
+      }
+    }
+  }
+}`
+
+	nodes := conn.Nodes
+	cursor, more := conn.PageInfo.next()
+	for more {
+		var response struct {
+			Node struct {
+				Comments reviewCommentConnection `json:"comments"`
+			} `json:"node"`
+		}
+
+		variables := map[string]interface{}{
+			"id":    reviewID,
+			"after": cursor,
+		}
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, fmt.Errorf("query review comments: %w", err)
+		}
+
+		nodes = append(nodes, response.Node.Comments.Nodes...)
+		cursor, more = response.Node.Comments.PageInfo.next()
+	}
+
+	return nodes, nil
+}
+
 type PendingReviewsOptions struct {
 	Reviewer string
 	First    int
 }
 
+// PendingReviews returns the reviewer's pending reviews with all of their
+// comments. First sets the page size used when listing reviews.
 func (c *Client) PendingReviews(pr *PRRef, opts PendingReviewsOptions) ([]*PendingReview, error) {
 	first := opts.First
 	if first <= 0 {
@@ -67,29 +303,24 @@ func (c *Client) PendingReviews(pr *PRRef, opts PendingReviewsOptions) ([]*Pendi
 		reviewer = login
 	}
 
-	const query = `query PendingReviews($owner: String!, $name: String!, $number: Int!, $first: Int!) {
+	const query = `query PendingReviews($owner: String!, $name: String!, $number: Int!, $first: Int!, $after: String) {
   repository(owner: $owner, name: $name) {
     pullRequest(number: $number) {
-      reviews(states: [PENDING], first: $first) {
+      reviews(states: [PENDING], first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
         nodes {
           id
           state
           url
           updatedAt
+          publishedAt
           author {
             login
           }
           comments(first: 100) {
             totalCount
-            nodes {
-              id
-              path
-              line
-              startLine
-              body
-              outdated
-              originalLine
-            }
+            pageInfo { hasNextPage endCursor }
+            nodes {` + reviewCommentFields + `}
           }
         }
       }
@@ -97,99 +328,95 @@ func (c *Client) PendingReviews(pr *PRRef, opts PendingReviewsOptions) ([]*Pendi
   }
 }`
 
-	variables := map[string]interface{}{
-		"owner":  pr.Owner,
-		"name":   pr.Repo,
-		"number": pr.Number,
-		"first":  first,
-	}
-
-	var response struct {
-		Repository struct {
-			PullRequest struct {
-				Reviews struct {
-					Nodes []struct {
-						ID        string `json:"id"`
-						State     string `json:"state"`
-						URL       string `json:"url"`
-						UpdatedAt string `json:"updatedAt"`
-						Author    struct {
-							Login string `json:"login"`
-						} `json:"author"`
-						Comments struct {
-							TotalCount int `json:"totalCount"`
-							Nodes      []struct {
-								ID           string `json:"id"`
-								Path         string `json:"path"`
-								Line         *int   `json:"line"`
-								StartLine    *int   `json:"startLine"`
-								Body         string `json:"body"`
-								Outdated     bool   `json:"outdated"`
-								OriginalLine *int   `json:"originalLine"`
-							} `json:"nodes"`
-						} `json:"comments"`
-					} `json:"nodes"`
-				} `json:"reviews"`
-			} `json:"pullRequest"`
-		} `json:"repository"`
-	}
-
-	if err := c.gql.Do(query, variables, &response); err != nil {
-		return nil, fmt.Errorf("query pending reviews: %w", err)
-	}
-
 	var results []*PendingReview
-	for _, node := range response.Repository.PullRequest.Reviews.Nodes {
-		authorLogin := strings.TrimSpace(node.Author.Login)
-		if !strings.EqualFold(authorLogin, reviewer) {
-			continue
+	cursor := ""
+	for {
+		variables := map[string]interface{}{
+			"owner":  pr.Owner,
+			"name":   pr.Repo,
+			"number": pr.Number,
+			"first":  first,
+			"after":  cursorVar(cursor),
 		}
 
-		id := strings.TrimSpace(node.ID)
-		if id == "" {
-			continue
+		var response struct {
+			Repository struct {
+				PullRequest struct {
+					Reviews struct {
+						PageInfo pageInfo `json:"pageInfo"`
+						Nodes    []struct {
+							ID        string `json:"id"`
+							State     string `json:"state"`
+							URL       string `json:"url"`
+							UpdatedAt string `json:"updatedAt"`
+							Author    struct {
+								Login string `json:"login"`
+							} `json:"author"`
+							Comments reviewCommentConnection `json:"comments"`
+						} `json:"nodes"`
+					} `json:"reviews"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
 		}
 
-		updatedAt, _ := time.Parse(time.RFC3339, node.UpdatedAt)
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, fmt.Errorf("query pending reviews: %w", err)
+		}
 
-		comments := make([]*ReviewComment, 0, len(node.Comments.Nodes))
-		for _, cmt := range node.Comments.Nodes {
-			cmtID := strings.TrimSpace(cmt.ID)
-			if cmtID == "" {
+		reviews := response.Repository.PullRequest.Reviews
+		for _, node := range reviews.Nodes {
+			authorLogin := strings.TrimSpace(node.Author.Login)
+			if !strings.EqualFold(authorLogin, reviewer) {
 				continue
 			}
 
-			line := 0
-			if cmt.Line != nil {
-				line = *cmt.Line
-			} else if cmt.OriginalLine != nil {
-				line = *cmt.OriginalLine
+			id := strings.TrimSpace(node.ID)
+			if id == "" {
+				continue
 			}
 
-			comments = append(comments, &ReviewComment{
-				ID:        cmtID,
-				Path:      cmt.Path,
-				Line:      line,
-				StartLine: cmt.StartLine,
-				Body:      cmt.Body,
-				Outdated:  cmt.Outdated,
+			updatedAt, _ := time.Parse(time.RFC3339, node.UpdatedAt)
+
+			nodes, err := c.remainingReviewComments(id, node.Comments)
+			if err != nil {
+				return nil, err
+			}
+
+			comments := make([]*ReviewComment, 0, len(nodes))
+			for _, cmt := range nodes {
+				comment := cmt.toReviewComment()
+				if comment.ID == "" {
+					continue
+				}
+				comments = append(comments, comment)
+			}
+
+			results = append(results, &PendingReview{
+				ID:         id,
+				State:      strings.ToUpper(node.State),
+				URL:        node.URL,
+				UpdatedAt:  updatedAt,
+				Author:     authorLogin,
+				Comments:   comments,
+				TotalCount: node.Comments.TotalCount,
 			})
 		}
 
-		results = append(results, &PendingReview{
-			ID:         id,
-			State:      strings.ToUpper(node.State),
-			URL:        node.URL,
-			UpdatedAt:  updatedAt,
-			Author:     authorLogin,
-			Comments:   comments,
-			TotalCount: node.Comments.TotalCount,
-		})
+		next, more := reviews.PageInfo.next()
+		if !more {
+			break
+		}
+		cursor = next
 	}
 
 	return results, nil
 }
 
+// ErrNoPendingReview reports that the reviewer has no pending review.
+var ErrNoPendingReview = errors.New("no pending review found")
+
+// LatestPendingReview returns the reviewer's most recently updated pending
+// review, or ErrNoPendingReview.
 func (c *Client) LatestPendingReview(pr *PRRef, opts PendingReviewsOptions) (*PendingReview, error) {
 	reviews, err := c.PendingReviews(pr, opts)
 	if err != nil {
@@ -197,7 +424,7 @@ func (c *Client) LatestPendingReview(pr *PRRef, opts PendingReviewsOptions) (*Pe
 	}
 
 	if len(reviews) == 0 {
-		return nil, fmt.Errorf("no pending review found")
+		return nil, ErrNoPendingReview
 	}
 
 	latest := reviews[0]
@@ -210,212 +437,249 @@ func (c *Client) LatestPendingReview(pr *PRRef, opts PendingReviewsOptions) (*Pe
 	return latest, nil
 }
 
+// AllCommentsOptions controls AllPRComments. Limit caps review comments and
+// PR comments separately; zero or less fetches everything.
 type AllCommentsOptions struct {
 	Limit  int
 	States []string
 }
 
 func (c *Client) AllPRComments(pr *PRRef, opts AllCommentsOptions) (*AllCommentsResult, error) {
-	limit := opts.Limit
-	if limit <= 0 {
-		limit = 100
+	result := &AllCommentsResult{}
+
+	reviewComments, truncated, err := c.allReviewComments(pr, opts)
+	if err != nil {
+		return nil, err
 	}
+	result.ReviewComments = reviewComments
+	result.Truncated = truncated
 
-	// Build query dynamically based on whether states filter is provided
-	var query string
-	variables := map[string]interface{}{
-		"owner":  pr.Owner,
-		"name":   pr.Repo,
-		"number": pr.Number,
-		"limit":  limit,
+	prComments, truncated, err := c.allIssueComments(pr, opts.Limit)
+	if err != nil {
+		return nil, err
 	}
+	result.PRComments = prComments
+	result.Truncated = result.Truncated || truncated
+
+	return result, nil
+}
 
+func (c *Client) allReviewComments(pr *PRRef, opts AllCommentsOptions) ([]*ReviewCommentWithState, bool, error) {
+	// Build query dynamically based on whether states filter is provided
+	params := "$owner: String!, $name: String!, $number: Int!, $after: String"
+	args := "first: 100, after: $after"
+	var gqlStates []string
 	if len(opts.States) > 0 {
-		query = `query AllPRComments($owner: String!, $name: String!, $number: Int!, $limit: Int!, $states: [PullRequestReviewState!]) {
+		params += ", $states: [PullRequestReviewState!]"
+		args += ", states: $states"
+		// Convert states to uppercase for GraphQL enum
+		gqlStates = make([]string, len(opts.States))
+		for i, s := range opts.States {
+			gqlStates[i] = strings.ToUpper(s)
+		}
+	}
+
+	query := fmt.Sprintf(`query AllPRReviewComments(%s) {
   repository(owner: $owner, name: $name) {
     pullRequest(number: $number) {
-      reviews(states: $states, first: $limit) {
-        totalCount
+      reviews(%s) {
+        pageInfo { hasNextPage endCursor }
         nodes {
           id
           state
           author { login }
           comments(first: 100) {
-            nodes {
-              id
-              path
-              line
-              startLine
-              body
-              outdated
-              originalLine
-              author { login }
-            }
+            pageInfo { hasNextPage endCursor }
+            nodes {%s}
           }
         }
       }
-      comments(first: $limit) {
-        totalCount
-        nodes {
-          id
-          body
-          author { login }
-          createdAt
-        }
-      }
     }
   }
-}`
-		// Convert states to uppercase for GraphQL enum
-		gqlStates := make([]string, len(opts.States))
-		for i, s := range opts.States {
-			gqlStates[i] = strings.ToUpper(s)
+}`, params, args, reviewCommentFields)
+
+	var comments []*ReviewCommentWithState
+	cursor := ""
+	for {
+		variables := map[string]interface{}{
+			"owner":  pr.Owner,
+			"name":   pr.Repo,
+			"number": pr.Number,
+			"after":  cursorVar(cursor),
+		}
+		if gqlStates != nil {
+			variables["states"] = gqlStates
+		}
+
+		var response struct {
+			Repository struct {
+				PullRequest struct {
+					Reviews struct {
+						PageInfo pageInfo `json:"pageInfo"`
+						Nodes    []struct {
+							ID     string `json:"id"`
+							State  string `json:"state"`
+							Author struct {
+								Login string `json:"login"`
+							} `json:"author"`
+							Comments reviewCommentConnection `json:"comments"`
+						} `json:"nodes"`
+					} `json:"reviews"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
+
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, false, fmt.Errorf("query all PR comments: %w", err)
 		}
-		variables["states"] = gqlStates
-	} else {
-		query = `query AllPRComments($owner: String!, $name: String!, $number: Int!, $limit: Int!) {
+
+		reviews := response.Repository.PullRequest.Reviews
+		for _, review := range reviews.Nodes {
+			reviewState := normalizeReviewState(review.State)
+			reviewAuthor := strings.TrimSpace(review.Author.Login)
+
+			nodes, err := c.remainingReviewComments(strings.TrimSpace(review.ID), review.Comments)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, cmt := range nodes {
+				comment := cmt.toReviewComment()
+				if comment.ID == "" {
+					continue
+				}
+				if opts.Limit > 0 && len(comments) == opts.Limit {
+					return comments, true, nil
+				}
+				if comment.Author == "" {
+					comment.Author = reviewAuthor
+				}
+
+				comments = append(comments, &ReviewCommentWithState{
+					ReviewComment: *comment,
+					State:         reviewState,
+				})
+			}
+		}
+
+		next, more := reviews.PageInfo.next()
+		if !more {
+			return comments, false, nil
+		}
+		cursor = next
+	}
+}
+
+// PRComments fetches the PR's discussion comments, up to limit when it is
+// positive, and reports whether more were left.
+func (c *Client) PRComments(pr *PRRef, limit int) ([]*PRComment, bool, error) {
+	return c.allIssueComments(pr, limit)
+}
+
+func (c *Client) allIssueComments(pr *PRRef, limit int) ([]*PRComment, bool, error) {
+	const query = `query AllPRIssueComments($owner: String!, $name: String!, $number: Int!, $first: Int!, $after: String) {
   repository(owner: $owner, name: $name) {
     pullRequest(number: $number) {
-      reviews(first: $limit) {
-        totalCount
-        nodes {
-          id
-          state
-          author { login }
-          comments(first: 100) {
-            nodes {
-              id
-              path
-              line
-              startLine
-              body
-              outdated
-              originalLine
-              author { login }
-            }
-          }
-        }
-      }
-      comments(first: $limit) {
-        totalCount
+      comments(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
         nodes {
           id
           body
           author { login }
           createdAt
+          isMinimized
+          minimizedReason
+          reactionGroups { content viewerHasReacted reactors(first: 20) { totalCount nodes { ... on Actor { login } } } }
         }
       }
     }
   }
 }`
-	}
 
-	var response struct {
-		Repository struct {
-			PullRequest struct {
-				Reviews struct {
-					TotalCount int `json:"totalCount"`
-					Nodes      []struct {
-						ID     string `json:"id"`
-						State  string `json:"state"`
-						Author struct {
-							Login string `json:"login"`
-						} `json:"author"`
-						Comments struct {
-							Nodes []struct {
-								ID           string `json:"id"`
-								Path         string `json:"path"`
-								Line         *int   `json:"line"`
-								StartLine    *int   `json:"startLine"`
-								Body         string `json:"body"`
-								Outdated     bool   `json:"outdated"`
-								OriginalLine *int   `json:"originalLine"`
-								Author       struct {
-									Login string `json:"login"`
-								} `json:"author"`
-							} `json:"nodes"`
-						} `json:"comments"`
-					} `json:"nodes"`
-				} `json:"reviews"`
-				Comments struct {
-					TotalCount int `json:"totalCount"`
-					Nodes      []struct {
-						ID     string `json:"id"`
-						Body   string `json:"body"`
-						Author struct {
-							Login string `json:"login"`
-						} `json:"author"`
-						CreatedAt string `json:"createdAt"`
-					} `json:"nodes"`
-				} `json:"comments"`
-			} `json:"pullRequest"`
-		} `json:"repository"`
-	}
+	var comments []*PRComment
+	cursor := ""
+	for {
+		variables := map[string]interface{}{
+			"owner":  pr.Owner,
+			"name":   pr.Repo,
+			"number": pr.Number,
+			"first":  pageFirst(limit, len(comments)),
+			"after":  cursorVar(cursor),
+		}
 
-	if err := c.gql.Do(query, variables, &response); err != nil {
-		return nil, fmt.Errorf("query all PR comments: %w", err)
-	}
+		var response struct {
+			Repository struct {
+				PullRequest struct {
+					Comments struct {
+						PageInfo pageInfo `json:"pageInfo"`
+						Nodes    []struct {
+							ID     string `json:"id"`
+							Body   string `json:"body"`
+							Author struct {
+								Login string `json:"login"`
+							} `json:"author"`
+							CreatedAt string `json:"createdAt"`
+							minimizable
+							reactable
+						} `json:"nodes"`
+					} `json:"comments"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
 
-	result := &AllCommentsResult{}
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, false, fmt.Errorf("query all PR comments: %w", err)
+		}
 
-	for _, review := range response.Repository.PullRequest.Reviews.Nodes {
-		reviewState := normalizeReviewState(review.State)
-		reviewAuthor := strings.TrimSpace(review.Author.Login)
+		conn := response.Repository.PullRequest.Comments
+		for i, cmt := range conn.Nodes {
+			if limit > 0 && len(comments) == limit {
+				return comments, i < len(conn.Nodes) || conn.PageInfo.HasNextPage, nil
+			}
 
-		for _, cmt := range review.Comments.Nodes {
 			cmtID := strings.TrimSpace(cmt.ID)
 			if cmtID == "" {
 				continue
 			}
 
-			line := 0
-			if cmt.Line != nil {
-				line = *cmt.Line
-			} else if cmt.OriginalLine != nil {
-				line = *cmt.OriginalLine
-			}
+			createdAt, _ := time.Parse(time.RFC3339, cmt.CreatedAt)
 
-			author := strings.TrimSpace(cmt.Author.Login)
-			if author == "" {
-				author = reviewAuthor
-			}
+			comments = append(comments, &PRComment{
+				ID:        cmtID,
+				Body:      cmt.Body,
+				Author:    strings.TrimSpace(cmt.Author.Login),
+				CreatedAt: createdAt,
 
-			result.ReviewComments = append(result.ReviewComments, &ReviewCommentWithState{
-				ReviewComment: ReviewComment{
-					ID:        cmtID,
-					Path:      cmt.Path,
-					Line:      line,
-					StartLine: cmt.StartLine,
-					Body:      cmt.Body,
-					Outdated:  cmt.Outdated,
-					Author:    author,
-				},
-				State: reviewState,
+				IsMinimized:     cmt.IsMinimized,
+				MinimizedReason: normalizeMinimizedReason(cmt.MinimizedReason),
+				Reactions:       cmt.reactions(),
 			})
 		}
-	}
 
-	for _, cmt := range response.Repository.PullRequest.Comments.Nodes {
-		cmtID := strings.TrimSpace(cmt.ID)
-		if cmtID == "" {
-			continue
+		next, more := conn.PageInfo.next()
+		if !more {
+			return comments, false, nil
 		}
-
-		createdAt, _ := time.Parse(time.RFC3339, cmt.CreatedAt)
-
-		result.PRComments = append(result.PRComments, &PRComment{
-			ID:        cmtID,
-			Body:      cmt.Body,
-			Author:    strings.TrimSpace(cmt.Author.Login),
-			CreatedAt: createdAt,
-		})
+		if limit > 0 && len(comments) == limit {
+			return comments, true, nil
+		}
+		cursor = next
 	}
+}
 
-	reviewCount := response.Repository.PullRequest.Reviews.TotalCount
-	prCommentCount := response.Repository.PullRequest.Comments.TotalCount
-	result.Truncated = reviewCount > limit || prCommentCount > limit
+// parseTime parses a GraphQL DateTime, treating null or malformed values as
+// the zero time.
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
 
-	return result, nil
+// intValue dereferences an optional GraphQL Int, treating null as zero.
+func intValue(n *int) int {
+	if n == nil {
+		return 0
+	}
+	return *n
 }
 
 func normalizeReviewState(state string) string {
@@ -433,19 +697,98 @@ func normalizeReviewState(state string) string {
 	}
 }
 
+// ThreadComment is one comment of a review thread. State is the normalized
+// state of the review it belongs to; "pending" marks the viewer's drafts.
+// DatabaseID is the number in the comment's #discussion_r URL fragment.
 type ThreadComment struct {
-	ID     string
-	Body   string
-	Author string
+	ID         string
+	DatabaseID int64
+	Body       string
+	Author     string
+	State      string
+	// PublishedAt is zero for drafts.
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt time.Time
+
+	IsMinimized     bool
+	MinimizedReason string
+	Reactions       []Reaction
 }
 
+// Thread is a review thread. Number is its 1-based position among all of
+// the PR's threads, whatever filters applied, and backs the short "t<n>"
+// reference. Line is the thread's current line, falling back
+// to OriginalLine when the thread is outdated; StartLine and
+// OriginalStartLine are zero for single-line threads, and all lines are zero
+// for file-level threads. DiffHunk is the hunk the first comment was made on,
+// ending at OriginalLine.
 type Thread struct {
-	ID         string
-	Path       string
-	Line       int
-	IsResolved bool
-	State      string
-	Comments   []*ThreadComment
+	ID                string
+	Number            int
+	Path              string
+	Line              int
+	StartLine         int
+	OriginalLine      int
+	OriginalStartLine int
+	DiffSide          string
+	StartDiffSide     string
+	DiffHunk          string
+	IsResolved        bool
+	IsOutdated        bool
+	IsFileLevel       bool
+	State             string
+	Comments          []*ThreadComment
+}
+
+// lastSubmitted returns the thread's last comment that is not a draft, or
+// nil when there is none.
+func (t *Thread) lastSubmitted() *ThreadComment {
+	for i := len(t.Comments) - 1; i >= 0; i-- {
+		if t.Comments[i].State != "pending" {
+			return t.Comments[i]
+		}
+	}
+	return nil
+}
+
+// LastActivity returns when the thread's latest comment was made, drafts
+// included, or the zero time for a thread without comments.
+func (t *Thread) LastActivity() time.Time {
+	var last time.Time
+	for _, c := range t.Comments {
+		if c.CreatedAt.After(last) {
+			last = c.CreatedAt
+		}
+	}
+	return last
+}
+
+// AwaitingReplyFrom reports whether an unresolved thread waits on login:
+// its last submitted comment is someone else's, and login took part in the
+// thread or, with isAuthor, authored the PR. Draft replies do not count as
+// answers until they are submitted.
+func (t *Thread) AwaitingReplyFrom(login string, isAuthor bool) bool {
+	last := t.lastSubmitted()
+	if t.IsResolved || last == nil || strings.EqualFold(last.Author, login) {
+		return false
+	}
+	if isAuthor {
+		return true
+	}
+	for _, c := range t.Comments {
+		if strings.EqualFold(c.Author, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// AwaitingOthers reports whether an unresolved thread waits on others
+// after login had the last submitted word.
+func (t *Thread) AwaitingOthers(login string) bool {
+	last := t.lastSubmitted()
+	return !t.IsResolved && last != nil && strings.EqualFold(last.Author, login)
 }
 
 type ThreadsResult struct {
@@ -453,33 +796,145 @@ type ThreadsResult struct {
 	Truncated bool
 }
 
+// ReviewThreadsOptions controls ReviewThreads. Limit caps the number of
+// threads returned after filtering; zero or less fetches every thread.
 type ReviewThreadsOptions struct {
 	Limit          int
 	UnresolvedOnly bool
 	States         []string
 }
 
-func (c *Client) ReviewThreads(pr *PRRef, opts ReviewThreadsOptions) (*ThreadsResult, error) {
-	limit := opts.Limit
-	if limit <= 0 {
-		limit = 100
+type threadCommentNode struct {
+	ID          string `json:"id"`
+	DatabaseID  int64  `json:"databaseId"`
+	Body        string `json:"body"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+	PublishedAt string `json:"publishedAt"`
+	minimizable
+	reactable
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	PullRequestReview struct {
+		State string `json:"state"`
+	} `json:"pullRequestReview"`
+}
+
+type threadCommentConnection struct {
+	PageInfo pageInfo            `json:"pageInfo"`
+	Nodes    []threadCommentNode `json:"nodes"`
+}
+
+// reviewState is the state of the review the thread's first comment
+// belongs to, which is the thread's state.
+func (conn threadCommentConnection) reviewState() string {
+	if len(conn.Nodes) == 0 {
+		return ""
+	}
+	return normalizeReviewState(conn.Nodes[0].PullRequestReview.State)
+}
+
+// keeps reports whether a thread passes the UnresolvedOnly and States
+// filters.
+func (o ReviewThreadsOptions) keeps(resolved bool, state string) bool {
+	if o.UnresolvedOnly && resolved {
+		return false
 	}
+	if len(o.States) == 0 {
+		return true
+	}
+	for _, s := range o.States {
+		if strings.EqualFold(state, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// remainingThreadComments pages through a thread's comments once the first
+// page, embedded in the parent query, reported more.
+func (c *Client) remainingThreadComments(threadID string, conn threadCommentConnection) ([]threadCommentNode, error) {
+	const query = `query ThreadComments($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on PullRequestReviewThread {
+      comments(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id
+          databaseId
+          body
+          isMinimized
+          minimizedReason
+          reactionGroups { content viewerHasReacted reactors(first: 20) { totalCount nodes { ... on Actor { login } } } }
+          createdAt
+          updatedAt
+          publishedAt
+          author { login }
+          pullRequestReview { state }
+        }
+      }
+    }
+  }
+}`
+
+	nodes := conn.Nodes
+	cursor, more := conn.PageInfo.next()
+	for more {
+		var response struct {
+			Node struct {
+				Comments threadCommentConnection `json:"comments"`
+			} `json:"node"`
+		}
+
+		variables := map[string]interface{}{
+			"id":    threadID,
+			"after": cursor,
+		}
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, fmt.Errorf("query thread comments: %w", err)
+		}
+
+		nodes = append(nodes, response.Node.Comments.Nodes...)
+		cursor, more = response.Node.Comments.PageInfo.next()
+	}
+
+	return nodes, nil
+}
 
-	const query = `query ReviewThreads($owner: String!, $name: String!, $number: Int!, $limit: Int!) {
+func (c *Client) ReviewThreads(pr *PRRef, opts ReviewThreadsOptions) (*ThreadsResult, error) {
+	const query = `query ReviewThreads($owner: String!, $name: String!, $number: Int!, $first: Int!, $after: String) {
   repository(owner: $owner, name: $name) {
     pullRequest(number: $number) {
-      reviewThreads(first: $limit) {
-        totalCount
+      reviewThreads(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
         nodes {
           id
           isResolved
+          isOutdated
+          subjectType
           path
           line
+          startLine
           originalLine
-          comments(first: 50) {
+          originalStartLine
+          diffSide
+          startDiffSide
+          firstComment: comments(first: 1) {
+            nodes { diffHunk }
+          }
+          comments(first: 100) {
+            pageInfo { hasNextPage endCursor }
             nodes {
               id
+              databaseId
               body
+              isMinimized
+              minimizedReason
+              reactionGroups { content viewerHasReacted reactors(first: 20) { totalCount nodes { ... on Actor { login } } } }
+              createdAt
+              updatedAt
+              publishedAt
               author { login }
               pullRequestReview { state }
             }
@@ -490,112 +945,150 @@ func (c *Client) ReviewThreads(pr *PRRef, opts ReviewThreadsOptions) (*ThreadsRe
   }
 }`
 
-	variables := map[string]interface{}{
-		"owner":  pr.Owner,
-		"name":   pr.Repo,
-		"number": pr.Number,
-		"limit":  limit,
-	}
-
-	var response struct {
-		Repository struct {
-			PullRequest struct {
-				ReviewThreads struct {
-					TotalCount int `json:"totalCount"`
-					Nodes      []struct {
-						ID           string `json:"id"`
-						IsResolved   bool   `json:"isResolved"`
-						Path         string `json:"path"`
-						Line         *int   `json:"line"`
-						OriginalLine *int   `json:"originalLine"`
-						Comments     struct {
-							Nodes []struct {
-								ID     string `json:"id"`
-								Body   string `json:"body"`
-								Author struct {
-									Login string `json:"login"`
-								} `json:"author"`
-								PullRequestReview struct {
-									State string `json:"state"`
-								} `json:"pullRequestReview"`
-							} `json:"nodes"`
-						} `json:"comments"`
-					} `json:"nodes"`
-				} `json:"reviewThreads"`
-			} `json:"pullRequest"`
-		} `json:"repository"`
-	}
-
-	if err := c.gql.Do(query, variables, &response); err != nil {
-		return nil, fmt.Errorf("query review threads: %w", err)
-	}
+	// With a filter, a page may hold few matching threads, so pages are
+	// fetched whole rather than cut to the threads still needed.
+	filtered := opts.UnresolvedOnly || len(opts.States) > 0
 
 	result := &ThreadsResult{}
-
-	for _, thread := range response.Repository.PullRequest.ReviewThreads.Nodes {
-		if opts.UnresolvedOnly && thread.IsResolved {
-			continue
+	cursor := ""
+	seen := 0
+	for {
+		first := pageFirst(opts.Limit, len(result.Threads))
+		if filtered {
+			first = pageSize
+		}
+		variables := map[string]interface{}{
+			"owner":  pr.Owner,
+			"name":   pr.Repo,
+			"number": pr.Number,
+			"first":  first,
+			"after":  cursorVar(cursor),
 		}
 
-		threadID := strings.TrimSpace(thread.ID)
-		if threadID == "" {
-			continue
+		var response struct {
+			Repository struct {
+				PullRequest struct {
+					ReviewThreads struct {
+						PageInfo pageInfo `json:"pageInfo"`
+						Nodes    []struct {
+							ID                string `json:"id"`
+							IsResolved        bool   `json:"isResolved"`
+							IsOutdated        bool   `json:"isOutdated"`
+							SubjectType       string `json:"subjectType"`
+							Path              string `json:"path"`
+							Line              *int   `json:"line"`
+							StartLine         *int   `json:"startLine"`
+							OriginalLine      *int   `json:"originalLine"`
+							OriginalStartLine *int   `json:"originalStartLine"`
+							DiffSide          string `json:"diffSide"`
+							StartDiffSide     string `json:"startDiffSide"`
+							FirstComment      struct {
+								Nodes []struct {
+									DiffHunk string `json:"diffHunk"`
+								} `json:"nodes"`
+							} `json:"firstComment"`
+							Comments threadCommentConnection `json:"comments"`
+						} `json:"nodes"`
+					} `json:"reviewThreads"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
 		}
 
-		// Get state from first comment's review
-		var threadState string
-		if len(thread.Comments.Nodes) > 0 {
-			threadState = normalizeReviewState(thread.Comments.Nodes[0].PullRequestReview.State)
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, fmt.Errorf("query review threads: %w", err)
 		}
 
-		// Filter by states if specified
-		if len(opts.States) > 0 {
-			matched := false
-			for _, s := range opts.States {
-				if strings.EqualFold(threadState, s) {
-					matched = true
-					break
+		conn := response.Repository.PullRequest.ReviewThreads
+		for _, thread := range conn.Nodes {
+			threadID := strings.TrimSpace(thread.ID)
+			threadState := thread.Comments.reviewState()
+			kept := threadID != "" && opts.keeps(thread.IsResolved, threadState)
+
+			if opts.Limit > 0 && len(result.Threads) == opts.Limit {
+				// Past the limit, only a thread the filters would keep
+				// makes the result truncated.
+				if kept {
+					result.Truncated = true
+					return result, nil
 				}
+				continue
 			}
-			if !matched {
+			seen++
+
+			if !kept {
 				continue
 			}
-		}
 
-		line := 0
-		if thread.Line != nil {
-			line = *thread.Line
-		} else if thread.OriginalLine != nil {
-			line = *thread.OriginalLine
-		}
+			line := 0
+			if thread.Line != nil {
+				line = *thread.Line
+			} else if thread.OriginalLine != nil {
+				line = *thread.OriginalLine
+			}
 
-		comments := make([]*ThreadComment, 0, len(thread.Comments.Nodes))
-		for _, cmt := range thread.Comments.Nodes {
-			cmtID := strings.TrimSpace(cmt.ID)
-			if cmtID == "" {
-				continue
+			nodes, err := c.remainingThreadComments(threadID, thread.Comments)
+			if err != nil {
+				return nil, err
+			}
+
+			comments := make([]*ThreadComment, 0, len(nodes))
+			for _, cmt := range nodes {
+				cmtID := strings.TrimSpace(cmt.ID)
+				if cmtID == "" {
+					continue
+				}
+
+				comments = append(comments, &ThreadComment{
+					ID:          cmtID,
+					DatabaseID:  cmt.DatabaseID,
+					Body:        cmt.Body,
+					Author:      strings.TrimSpace(cmt.Author.Login),
+					State:       normalizeReviewState(cmt.PullRequestReview.State),
+					CreatedAt:   parseTime(cmt.CreatedAt),
+					UpdatedAt:   parseTime(cmt.UpdatedAt),
+					PublishedAt: parseTime(cmt.PublishedAt),
+
+					IsMinimized:     cmt.IsMinimized,
+					MinimizedReason: normalizeMinimizedReason(cmt.MinimizedReason),
+					Reactions:       cmt.reactions(),
+				})
+			}
+
+			var diffHunk string
+			if len(thread.FirstComment.Nodes) > 0 {
+				diffHunk = thread.FirstComment.Nodes[0].DiffHunk
 			}
 
-			comments = append(comments, &ThreadComment{
-				ID:     cmtID,
-				Body:   cmt.Body,
-				Author: strings.TrimSpace(cmt.Author.Login),
+			result.Threads = append(result.Threads, &Thread{
+				ID:                threadID,
+				Number:            seen,
+				Path:              thread.Path,
+				Line:              line,
+				StartLine:         intValue(thread.StartLine),
+				OriginalLine:      intValue(thread.OriginalLine),
+				OriginalStartLine: intValue(thread.OriginalStartLine),
+				DiffSide:          thread.DiffSide,
+				StartDiffSide:     thread.StartDiffSide,
+				DiffHunk:          diffHunk,
+				IsResolved:        thread.IsResolved,
+				IsOutdated:        thread.IsOutdated,
+				IsFileLevel:       thread.SubjectType == "FILE",
+				State:             threadState,
+				Comments:          comments,
 			})
 		}
 
-		result.Threads = append(result.Threads, &Thread{
-			ID:         threadID,
-			Path:       thread.Path,
-			Line:       line,
-			IsResolved: thread.IsResolved,
-			State:      threadState,
-			Comments:   comments,
-		})
+		next, more := conn.PageInfo.next()
+		if !more {
+			return result, nil
+		}
+		if !filtered && opts.Limit > 0 && len(result.Threads) == opts.Limit {
+			// Without a filter every thread on the next page would be kept.
+			result.Truncated = true
+			return result, nil
+		}
+		cursor = next
 	}
-
-	result.Truncated = response.Repository.PullRequest.ReviewThreads.TotalCount > limit
-
-	return result, nil
 }
 
 // ThreadIDByComment finds the review thread node ID containing the given
@@ -3,7 +3,10 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 )
 
 // mockGQLClient implements GraphQLClient interface for testing
@@ -61,7 +64,8 @@ func TestClientResolvePR(t *testing.T) {
 				"repository": {
 					"pullRequest": {
 						"id": "PR_123abc",
-						"headRefOid": "abc123def456"
+						"headRefOid": "abc123def456",
+						"author": {"login": "octocat"}
 					}
 				}
 			}`
@@ -80,6 +84,9 @@ func TestClientResolvePR(t *testing.T) {
 		if identity.HeadRefOID != "abc123def456" {
 			t.Errorf("HeadRefOID = %q, want %q", identity.HeadRefOID, "abc123def456")
 		}
+		if identity.Author != "octocat" {
+			t.Errorf("Author = %q, want %q", identity.Author, "octocat")
+		}
 	})
 
 	t.Run("PR not found", func(t *testing.T) {
@@ -306,13 +313,62 @@ func TestClientAllPRComments(t *testing.T) {
 		}
 	})
 
+	t.Run("pending replies", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			resp := `{
+				"repository": {
+					"pullRequest": {
+						"reviews": {
+							"nodes": [
+								{
+									"id": "PRR_2",
+									"state": "PENDING",
+									"author": {"login": "me"},
+									"comments": {
+										"nodes": [
+											{"id": "PRRC_2", "path": "main.go", "line": 5, "body": "Will do", "replyTo": {"id": "PRRC_1"}, "isMinimized": true, "minimizedReason": "OFF_TOPIC", "author": {"login": "me"}}
+										]
+									}
+								}
+							]
+						},
+						"comments": {"nodes": []}
+					}
+				}
+			}`
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.AllPRComments(pr, AllCommentsOptions{States: []string{"pending"}})
+		if err != nil {
+			t.Fatalf("AllPRComments() unexpected error: %v", err)
+		}
+		if len(result.ReviewComments) != 1 {
+			t.Fatalf("ReviewComments length = %d, want 1", len(result.ReviewComments))
+		}
+		c := result.ReviewComments[0]
+		if c.State != "pending" || c.ReplyToID != "PRRC_1" {
+			t.Errorf("comment = %s replying to %q, want a pending reply to PRRC_1", c.State, c.ReplyToID)
+		}
+		if !c.IsMinimized || c.MinimizedReason != "off-topic" {
+			t.Errorf("comment minimized = %v (%q), want hidden as off-topic", c.IsMinimized, c.MinimizedReason)
+		}
+	})
+
 	t.Run("truncation flag", func(t *testing.T) {
 		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
 			resp := `{
 				"repository": {
 					"pullRequest": {
-						"reviews": {"totalCount": 150, "nodes": []},
-						"comments": {"totalCount": 50, "nodes": []}
+						"reviews": {"pageInfo": {"hasNextPage": false}, "nodes": []},
+						"comments": {
+							"pageInfo": {"hasNextPage": true, "endCursor": "c2"},
+							"nodes": [
+								{"id": "IC_1", "body": "one", "author": {"login": "a"}, "createdAt": "2024-01-15T10:00:00Z"},
+								{"id": "IC_2", "body": "two", "author": {"login": "a"}, "createdAt": "2024-01-15T11:00:00Z"}
+							]
+						}
 					}
 				}
 			}`
@@ -320,13 +376,109 @@ func TestClientAllPRComments(t *testing.T) {
 		})
 
 		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
-		result, err := client.AllPRComments(pr, AllCommentsOptions{Limit: 100})
+		result, err := client.AllPRComments(pr, AllCommentsOptions{Limit: 2})
 
 		if err != nil {
 			t.Fatalf("AllPRComments() unexpected error: %v", err)
 		}
 		if !result.Truncated {
-			t.Error("expected Truncated = true when totalCount > limit")
+			t.Error("expected Truncated = true when more pages remain past the limit")
+		}
+		if len(result.PRComments) != 2 {
+			t.Errorf("PRComments length = %d, want 2", len(result.PRComments))
+		}
+	})
+
+	t.Run("follows review and nested comment cursors", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			var resp string
+			switch {
+			case strings.Contains(query, "query ReviewComments"):
+				resp = `{"node": {"comments": {"pageInfo": {"hasNextPage": false}, "nodes": [
+					{"id": "PRRC_2", "path": "a.go", "line": 2, "body": "second", "author": {"login": "r"}}
+				]}}}`
+			case strings.Contains(query, "query AllPRReviewComments") && variables["after"] == nil:
+				resp = `{"repository": {"pullRequest": {"reviews": {
+					"pageInfo": {"hasNextPage": true, "endCursor": "r1"},
+					"nodes": [{"id": "PRR_1", "state": "COMMENTED", "author": {"login": "r"}, "comments": {
+						"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+						"nodes": [{"id": "PRRC_1", "path": "a.go", "line": 1, "body": "first", "author": {"login": "r"}}]
+					}}]
+				}}}}`
+			case strings.Contains(query, "query AllPRReviewComments"):
+				resp = `{"repository": {"pullRequest": {"reviews": {
+					"pageInfo": {"hasNextPage": false},
+					"nodes": [{"id": "PRR_2", "state": "APPROVED", "author": {"login": "s"}, "comments": {
+						"pageInfo": {"hasNextPage": false},
+						"nodes": [{"id": "PRRC_3", "path": "b.go", "line": 3, "body": "third", "author": {"login": "s"}}]
+					}}]
+				}}}}`
+			default:
+				resp = `{"repository": {"pullRequest": {"comments": {"pageInfo": {"hasNextPage": false}, "nodes": []}}}}`
+			}
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.AllPRComments(pr, AllCommentsOptions{})
+
+		if err != nil {
+			t.Fatalf("AllPRComments() unexpected error: %v", err)
+		}
+		if result.Truncated {
+			t.Error("expected Truncated = false when every page was fetched")
+		}
+		var ids []string
+		for _, c := range result.ReviewComments {
+			ids = append(ids, c.ID)
+		}
+		if got := strings.Join(ids, ","); got != "PRRC_1,PRRC_2,PRRC_3" {
+			t.Errorf("review comment IDs = %q, want %q", got, "PRRC_1,PRRC_2,PRRC_3")
+		}
+	})
+
+	t.Run("reactions on comments past the first page", func(t *testing.T) {
+		reacted := `"reactionGroups": [{"content": "THUMBS_UP", "viewerHasReacted": false, "reactors": {"totalCount": 1, "nodes": [{"login": "author"}]}}]`
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			var resp string
+			switch {
+			case strings.Contains(query, "query ReviewComments"):
+				if !strings.Contains(query, "reactionGroups") {
+					t.Error("later pages of review comments should fetch reactionGroups")
+				}
+				resp = `{"node": {"comments": {"pageInfo": {"hasNextPage": false}, "nodes": [
+					{"id": "PRRC_101", "path": "a.go", "line": 101, "body": "late", "author": {"login": "r"}, ` + reacted + `}
+				]}}}`
+			case strings.Contains(query, "query AllPRReviewComments"):
+				nodes := make([]string, 100)
+				for i := range nodes {
+					nodes[i] = fmt.Sprintf(`{"id": "PRRC_%d", "path": "a.go", "line": %d, "body": "early", "author": {"login": "r"}, %s}`, i+1, i+1, reacted)
+				}
+				resp = `{"repository": {"pullRequest": {"reviews": {
+					"pageInfo": {"hasNextPage": false},
+					"nodes": [{"id": "PRR_1", "state": "COMMENTED", "author": {"login": "r"}, "comments": {
+						"pageInfo": {"hasNextPage": true, "endCursor": "c100"},
+						"nodes": [` + strings.Join(nodes, ",") + `]
+					}}]
+				}}}}`
+			default:
+				resp = `{"repository": {"pullRequest": {"comments": {"pageInfo": {"hasNextPage": false}, "nodes": []}}}}`
+			}
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.AllPRComments(pr, AllCommentsOptions{Limit: 0})
+		if err != nil {
+			t.Fatalf("AllPRComments() unexpected error: %v", err)
+		}
+		if len(result.ReviewComments) != 101 {
+			t.Fatalf("ReviewComments length = %d, want 101", len(result.ReviewComments))
+		}
+		for _, c := range result.ReviewComments {
+			if !ReactedBy(c.Reactions, "author") {
+				t.Errorf("%s reactions = %+v, want author's 👍", c.ID, c.Reactions)
+			}
 		}
 	})
 }
@@ -343,11 +495,17 @@ func TestClientReviewThreads(t *testing.T) {
 								{
 									"id": "PRRT_1",
 									"isResolved": false,
+									"isOutdated": true,
 									"path": "main.go",
 									"line": 10,
+									"startLine": 8,
+									"originalLine": 12,
+									"originalStartLine": 10,
+									"diffSide": "RIGHT",
+									"firstComment": {"nodes": [{"diffHunk": "@@ -1,2 +1,3 @@\n a\n+b"}]},
 									"comments": {
 										"nodes": [
-											{"id": "PRRC_1", "body": "Fix this", "author": {"login": "reviewer"}, "pullRequestReview": {"state": "CHANGES_REQUESTED"}}
+											{"id": "PRRC_1", "body": "Fix this", "createdAt": "2024-01-15T10:00:00Z", "author": {"login": "reviewer"}, "pullRequestReview": {"state": "CHANGES_REQUESTED"}}
 										]
 									}
 								}
@@ -376,6 +534,57 @@ func TestClientReviewThreads(t *testing.T) {
 		if thread.State != "changes_requested" {
 			t.Errorf("thread.State = %q, want %q", thread.State, "changes_requested")
 		}
+		if !thread.IsOutdated {
+			t.Error("thread.IsOutdated = false, want true")
+		}
+		if thread.StartLine != 8 || thread.OriginalLine != 12 || thread.OriginalStartLine != 10 {
+			t.Errorf("thread lines = start %d, original %d-%d; want 8, 10-12", thread.StartLine, thread.OriginalStartLine, thread.OriginalLine)
+		}
+		if thread.DiffSide != "RIGHT" {
+			t.Errorf("thread.DiffSide = %q, want RIGHT", thread.DiffSide)
+		}
+		if !strings.HasSuffix(thread.DiffHunk, "+b") {
+			t.Errorf("thread.DiffHunk = %q, want the first comment's hunk", thread.DiffHunk)
+		}
+		if got := thread.Comments[0].State; got != "changes_requested" {
+			t.Errorf("comment.State = %q, want %q", got, "changes_requested")
+		}
+		if want := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC); !thread.LastActivity().Equal(want) {
+			t.Errorf("thread.LastActivity() = %v, want %v", thread.LastActivity(), want)
+		}
+	})
+
+	t.Run("file-level threads", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			resp := `{
+				"repository": {
+					"pullRequest": {
+						"reviewThreads": {
+							"nodes": [
+								{"id": "PRRT_1", "subjectType": "FILE", "path": "logo.png", "line": null, "comments": {"nodes": [{"id": "C1", "body": "SVG?", "isMinimized": true, "minimizedReason": "outdated", "author": {"login": "u"}, "pullRequestReview": {"state": "COMMENTED"}}]}},
+								{"id": "PRRT_2", "subjectType": "LINE", "path": "a.go", "line": 2, "comments": {"nodes": [{"id": "C2", "body": "nit", "author": {"login": "u"}, "pullRequestReview": {"state": "COMMENTED"}}]}}
+							]
+						}
+					}
+				}
+			}`
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.ReviewThreads(pr, ReviewThreadsOptions{})
+		if err != nil {
+			t.Fatalf("ReviewThreads() unexpected error: %v", err)
+		}
+		if !result.Threads[0].IsFileLevel || result.Threads[0].Line != 0 {
+			t.Errorf("thread 1: IsFileLevel = %v, Line = %d; want a file-level thread", result.Threads[0].IsFileLevel, result.Threads[0].Line)
+		}
+		if c := result.Threads[0].Comments[0]; !c.IsMinimized || c.MinimizedReason != "outdated" {
+			t.Errorf("comment minimized = %v (%q), want hidden as outdated", c.IsMinimized, c.MinimizedReason)
+		}
+		if result.Threads[1].IsFileLevel {
+			t.Error("thread 2: IsFileLevel = true, want false for a line thread")
+		}
 	})
 
 	t.Run("filters unresolved only", func(t *testing.T) {
@@ -408,6 +617,31 @@ func TestClientReviewThreads(t *testing.T) {
 		if result.Threads[0].ID != "PRRT_1" {
 			t.Errorf("expected unresolved thread PRRT_1")
 		}
+		if result.Threads[0].Number != 1 {
+			t.Errorf("Number = %d, want 1", result.Threads[0].Number)
+		}
+	})
+
+	t.Run("numbers threads before filtering", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			resp := `{"repository": {"pullRequest": {"reviewThreads": {"nodes": [
+				{"id": "PRRT_1", "isResolved": true, "path": "a.go", "comments": {"nodes": [{"id": "PRRC_1", "databaseId": 11, "body": "x", "author": {"login": "u"}, "pullRequestReview": {"state": "COMMENTED"}}]}},
+				{"id": "PRRT_2", "isResolved": false, "path": "b.go", "comments": {"nodes": [{"id": "PRRC_2", "databaseId": 22, "body": "y", "author": {"login": "u"}, "pullRequestReview": {"state": "COMMENTED"}}]}}
+			]}}}}`
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.ReviewThreads(pr, ReviewThreadsOptions{UnresolvedOnly: true})
+		if err != nil {
+			t.Fatalf("ReviewThreads() unexpected error: %v", err)
+		}
+		if len(result.Threads) != 1 || result.Threads[0].Number != 2 {
+			t.Fatalf("Threads = %+v, want only PRRT_2 numbered 2", result.Threads)
+		}
+		if got := result.Threads[0].Comments[0].DatabaseID; got != 22 {
+			t.Errorf("DatabaseID = %d, want 22", got)
+		}
 	})
 
 	t.Run("filters by states", func(t *testing.T) {
@@ -443,6 +677,187 @@ func TestClientReviewThreads(t *testing.T) {
 	})
 }
 
+func TestClientReviewThreadsPagination(t *testing.T) {
+	threadPage := func(id, cursor string, hasNext bool) string {
+		return fmt.Sprintf(`{"repository": {"pullRequest": {"reviewThreads": {
+			"pageInfo": {"hasNextPage": %t, "endCursor": %q},
+			"nodes": [{"id": %q, "isResolved": false, "path": "a.go", "line": 1, "comments": {
+				"pageInfo": {"hasNextPage": false},
+				"nodes": [{"id": "C_%s", "body": "b", "author": {"login": "u"}, "pullRequestReview": {"state": "COMMENTED"}}]
+			}}]
+		}}}}`, hasNext, cursor, id, id)
+	}
+
+	t.Run("fetches every page without a limit", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			if variables["after"] == nil {
+				return json.Unmarshal([]byte(threadPage("PRRT_1", "t1", true)), response)
+			}
+			return json.Unmarshal([]byte(threadPage("PRRT_2", "", false)), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.ReviewThreads(pr, ReviewThreadsOptions{})
+
+		if err != nil {
+			t.Fatalf("ReviewThreads() unexpected error: %v", err)
+		}
+		if len(result.Threads) != 2 {
+			t.Fatalf("Threads length = %d, want 2", len(result.Threads))
+		}
+		if result.Truncated {
+			t.Error("expected Truncated = false when every page was fetched")
+		}
+	})
+
+	t.Run("limit caps results and flags truncation", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			calls++
+			if variables["first"] != 1 {
+				t.Errorf("first = %v, want 1", variables["first"])
+			}
+			return json.Unmarshal([]byte(threadPage("PRRT_1", "t1", true)), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.ReviewThreads(pr, ReviewThreadsOptions{Limit: 1})
+
+		if err != nil {
+			t.Fatalf("ReviewThreads() unexpected error: %v", err)
+		}
+		if len(result.Threads) != 1 {
+			t.Fatalf("Threads length = %d, want 1", len(result.Threads))
+		}
+		if !result.Truncated {
+			t.Error("expected Truncated = true when more pages remain past the limit")
+		}
+		if calls != 1 {
+			t.Errorf("GraphQL calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("limit reached with only filtered threads left", func(t *testing.T) {
+		page := func(lastResolved bool) string {
+			return fmt.Sprintf(`{"repository": {"pullRequest": {"reviewThreads": {
+				"pageInfo": {"hasNextPage": false},
+				"nodes": [
+					{"id": "PRRT_1", "isResolved": false, "path": "a.go", "line": 1, "comments": {"nodes": []}},
+					{"id": "PRRT_2", "isResolved": true, "path": "b.go", "line": 2, "comments": {"nodes": []}},
+					{"id": "PRRT_3", "isResolved": %t, "path": "c.go", "line": 3, "comments": {"nodes": []}}
+				]
+			}}}}`, lastResolved)
+		}
+
+		for _, tt := range []struct {
+			name         string
+			lastResolved bool
+			want         bool
+		}{
+			{"all resolved", true, false},
+			{"one unresolved", false, true},
+		} {
+			client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+				return json.Unmarshal([]byte(page(tt.lastResolved)), response)
+			})
+
+			pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+			result, err := client.ReviewThreads(pr, ReviewThreadsOptions{Limit: 1, UnresolvedOnly: true})
+			if err != nil {
+				t.Fatalf("%s: ReviewThreads() unexpected error: %v", tt.name, err)
+			}
+			if len(result.Threads) != 1 {
+				t.Fatalf("%s: Threads length = %d, want 1", tt.name, len(result.Threads))
+			}
+			if result.Truncated != tt.want {
+				t.Errorf("%s: Truncated = %v, want %v", tt.name, result.Truncated, tt.want)
+			}
+		}
+	})
+
+	t.Run("filtered limit checks later pages for matches", func(t *testing.T) {
+		for _, tt := range []struct {
+			name         string
+			lastResolved bool
+			want         bool
+		}{
+			{"later pages all resolved", true, false},
+			{"later page with an unresolved thread", false, true},
+		} {
+			calls := 0
+			client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+				calls++
+				if variables["first"] != pageSize {
+					t.Errorf("%s: first = %v, want full pages of %d", tt.name, variables["first"], pageSize)
+				}
+				resp := `{"repository": {"pullRequest": {"reviewThreads": {
+					"pageInfo": {"hasNextPage": true, "endCursor": "p1"},
+					"nodes": [
+						{"id": "PRRT_1", "isResolved": false, "path": "a.go", "line": 1, "comments": {"nodes": []}},
+						{"id": "PRRT_2", "isResolved": true, "path": "b.go", "line": 2, "comments": {"nodes": []}}
+					]
+				}}}}`
+				if variables["after"] == "p1" {
+					resp = fmt.Sprintf(`{"repository": {"pullRequest": {"reviewThreads": {
+						"pageInfo": {"hasNextPage": false},
+						"nodes": [{"id": "PRRT_3", "isResolved": %t, "path": "c.go", "line": 3, "comments": {"nodes": []}}]
+					}}}}`, tt.lastResolved)
+				}
+				return json.Unmarshal([]byte(resp), response)
+			})
+
+			pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+			result, err := client.ReviewThreads(pr, ReviewThreadsOptions{Limit: 1, UnresolvedOnly: true})
+			if err != nil {
+				t.Fatalf("%s: ReviewThreads() unexpected error: %v", tt.name, err)
+			}
+			if len(result.Threads) != 1 {
+				t.Fatalf("%s: Threads length = %d, want 1", tt.name, len(result.Threads))
+			}
+			if result.Truncated != tt.want {
+				t.Errorf("%s: Truncated = %v, want %v", tt.name, result.Truncated, tt.want)
+			}
+			if calls != 2 {
+				t.Errorf("%s: GraphQL calls = %d, want 2", tt.name, calls)
+			}
+		}
+	})
+
+	t.Run("follows nested comment cursor", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			if strings.Contains(query, "query ThreadComments") {
+				if variables["id"] != "PRRT_1" || variables["after"] != "c1" {
+					t.Errorf("nested variables = %v, want id PRRT_1 after c1", variables)
+				}
+				return json.Unmarshal([]byte(`{"node": {"comments": {"pageInfo": {"hasNextPage": false}, "nodes": [
+					{"id": "PRRC_reply", "body": "reply", "author": {"login": "v"}, "pullRequestReview": {"state": "COMMENTED"}}
+				]}}}`), response)
+			}
+			return json.Unmarshal([]byte(`{"repository": {"pullRequest": {"reviewThreads": {
+				"pageInfo": {"hasNextPage": false},
+				"nodes": [{"id": "PRRT_1", "isResolved": false, "path": "a.go", "line": 1, "comments": {
+					"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+					"nodes": [{"id": "PRRC_head", "body": "head", "author": {"login": "u"}, "pullRequestReview": {"state": "COMMENTED"}}]
+				}}]
+			}}}}`), response)
+		})
+
+		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
+		result, err := client.ReviewThreads(pr, ReviewThreadsOptions{})
+
+		if err != nil {
+			t.Fatalf("ReviewThreads() unexpected error: %v", err)
+		}
+		if len(result.Threads) != 1 {
+			t.Fatalf("Threads length = %d, want 1", len(result.Threads))
+		}
+		comments := result.Threads[0].Comments
+		if len(comments) != 2 || comments[1].ID != "PRRC_reply" {
+			t.Errorf("comments = %d, want head and reply", len(comments))
+		}
+	})
+}
+
 func TestLatestPendingReview(t *testing.T) {
 	t.Run("returns most recent", func(t *testing.T) {
 		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
@@ -487,8 +902,107 @@ func TestLatestPendingReview(t *testing.T) {
 		pr := &PRRef{Owner: "owner", Repo: "repo", Number: 1}
 		_, err := client.LatestPendingReview(pr, PendingReviewsOptions{Reviewer: "user"})
 
+		if !errors.Is(err, ErrNoPendingReview) {
+			t.Errorf("LatestPendingReview() error = %v, want ErrNoPendingReview", err)
+		}
+	})
+}
+
+func TestClientReviewCommentByID(t *testing.T) {
+	t.Run("returns comment with hunk", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			if variables["id"] != "PRRC_1" {
+				t.Errorf("id = %v, want PRRC_1", variables["id"])
+			}
+			resp := `{"node": {"id": "PRRC_1", "path": "main.go", "line": 12, "body": "Fix this", "diffHunk": "@@ -1 +1 @@\n+x", "createdAt": "2024-01-15T10:00:00Z", "updatedAt": "2024-01-16T10:00:00Z", "publishedAt": "2024-01-15T10:05:00Z", "author": {"login": "reviewer"}}}`
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		comment, err := client.ReviewCommentByID("PRRC_1")
+		if err != nil {
+			t.Fatalf("ReviewCommentByID() unexpected error: %v", err)
+		}
+		if comment.Body != "Fix this" || comment.Path != "main.go" || comment.Line != 12 {
+			t.Errorf("comment = %+v", comment)
+		}
+		if comment.CreatedAt.Day() != 15 || comment.UpdatedAt.Day() != 16 {
+			t.Errorf("comment times = %v, %v; want created on the 15th, updated on the 16th", comment.CreatedAt, comment.UpdatedAt)
+		}
+		if comment.PublishedAt.Minute() != 5 {
+			t.Errorf("PublishedAt = %v, want 10:05", comment.PublishedAt)
+		}
+		if comment.DiffHunk != "@@ -1 +1 @@\n+x" {
+			t.Errorf("DiffHunk = %q", comment.DiffHunk)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"node": null}`), response)
+		})
+		_, err := client.ReviewCommentByID("PRRC_missing")
+		if err == nil {
+			t.Error("ReviewCommentByID() expected error for a missing comment")
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		_, err := client.ReviewCommentByID("IC_1")
 		if err == nil {
-			t.Error("LatestPendingReview() expected error when no reviews")
+			t.Error("ReviewCommentByID() expected error for a non review comment ID")
 		}
 	})
 }
+
+func TestThreadAwaiting(t *testing.T) {
+	thread := func(resolved bool, authors ...string) *Thread {
+		th := &Thread{IsResolved: resolved}
+		for _, a := range authors {
+			state := "commented"
+			if strings.HasSuffix(a, "*") {
+				a, state = strings.TrimSuffix(a, "*"), "pending"
+			}
+			th.Comments = append(th.Comments, &ThreadComment{Author: a, State: state})
+		}
+		return th
+	}
+
+	tests := []struct {
+		name     string
+		thread   *Thread
+		isAuthor bool
+		wantMe   bool
+		wantThem bool
+	}{
+		{"reviewer replied to me", thread(false, "me", "bob"), false, true, false},
+		{"I replied last", thread(false, "bob", "Me"), false, false, true},
+		{"not involved", thread(false, "bob", "carol"), false, false, false},
+		{"not involved but PR author", thread(false, "bob"), true, true, false},
+		{"draft reply only", thread(false, "bob", "me*"), true, true, false},
+		{"resolved", thread(true, "me", "bob"), false, false, false},
+		{"no comments", thread(false), true, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.thread.AwaitingReplyFrom("me", tt.isAuthor); got != tt.wantMe {
+				t.Errorf("AwaitingReplyFrom() = %v, want %v", got, tt.wantMe)
+			}
+			if got := tt.thread.AwaitingOthers("me"); got != tt.wantThem {
+				t.Errorf("AwaitingOthers() = %v, want %v", got, tt.wantThem)
+			}
+		})
+	}
+}
+
+func TestThreadLastActivity(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2026, 3, 1, hour, 0, 0, 0, time.UTC) }
+	thread := &Thread{Comments: []*ThreadComment{{CreatedAt: at(9)}, {CreatedAt: at(11)}, {}}}
+
+	if got := thread.LastActivity(); !got.Equal(at(11)) {
+		t.Errorf("LastActivity() = %v, want %v", got, at(11))
+	}
+	if got := (&Thread{}).LastActivity(); !got.IsZero() {
+		t.Errorf("LastActivity() without comments = %v, want zero", got)
+	}
+}
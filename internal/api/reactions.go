@@ -0,0 +1,273 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// reactionKinds lists GitHub's reactions in the order it displays them, by
+// the name ParseReaction accepts, GraphQL ReactionContent value and emoji.
+var reactionKinds = []struct {
+	name    string
+	content string
+	emoji   string
+}{
+	{"+1", "THUMBS_UP", "👍"},
+	{"-1", "THUMBS_DOWN", "👎"},
+	{"laugh", "LAUGH", "😄"},
+	{"hooray", "HOORAY", "🎉"},
+	{"confused", "CONFUSED", "😕"},
+	{"heart", "HEART", "❤️"},
+	{"rocket", "ROCKET", "🚀"},
+	{"eyes", "EYES", "👀"},
+}
+
+// Reactions lists the reaction names ParseReaction accepts and Reaction
+// reports.
+var Reactions = func() []string {
+	names := make([]string, len(reactionKinds))
+	for i, k := range reactionKinds {
+		names[i] = k.name
+	}
+	return names
+}()
+
+// ParseReaction maps a reaction given by name ("+1", "eyes"), GraphQL value
+// ("THUMBS_UP") or emoji ("👍") to its name.
+func ParseReaction(reaction string) (string, error) {
+	reaction = strings.TrimSpace(reaction)
+	for _, k := range reactionKinds {
+		if strings.EqualFold(reaction, k.name) || strings.EqualFold(reaction, k.content) || reaction == k.emoji || reaction == strings.TrimSuffix(k.emoji, "\ufe0f") {
+			return k.name, nil
+		}
+	}
+	return "", fmt.Errorf("invalid reaction %q: expected one of %s", reaction, strings.Join(Reactions, ", "))
+}
+
+// ReactionEmoji returns the emoji of a reaction name, or the name itself
+// when it is unknown.
+func ReactionEmoji(name string) string {
+	for _, k := range reactionKinds {
+		if k.name == name {
+			return k.emoji
+		}
+	}
+	return name
+}
+
+func reactionContent(name string) string {
+	for _, k := range reactionKinds {
+		if k.name == name {
+			return k.content
+		}
+	}
+	return ""
+}
+
+func reactionName(content string) string {
+	for _, k := range reactionKinds {
+		if k.content == content {
+			return k.name
+		}
+	}
+	return strings.ToLower(content)
+}
+
+// Reaction counts one kind of emoji reaction on a comment. Viewer reports
+// whether the authenticated user is among those who reacted; Users holds
+// the logins of up to the first 20 of them, and Truncated is set when there
+// were more. CompleteReactions fetches the rest.
+type Reaction struct {
+	Name      string
+	Count     int
+	Viewer    bool
+	Users     []string
+	Truncated bool
+}
+
+// reactable holds the reaction groups shared by every kind of comment.
+type reactable struct {
+	ReactionGroups []struct {
+		Content          string `json:"content"`
+		ViewerHasReacted bool   `json:"viewerHasReacted"`
+		Reactors         struct {
+			TotalCount int `json:"totalCount"`
+			Nodes      []struct {
+				Login string `json:"login"`
+			} `json:"nodes"`
+		} `json:"reactors"`
+	} `json:"reactionGroups"`
+}
+
+// reactions returns the kinds of reaction the comment received, in GitHub's
+// display order.
+func (r reactable) reactions() []Reaction {
+	var reactions []Reaction
+	for _, g := range r.ReactionGroups {
+		if g.Reactors.TotalCount == 0 {
+			continue
+		}
+		reaction := Reaction{
+			Name:      reactionName(g.Content),
+			Count:     g.Reactors.TotalCount,
+			Viewer:    g.ViewerHasReacted,
+			Truncated: len(g.Reactors.Nodes) < g.Reactors.TotalCount,
+		}
+		for _, n := range g.Reactors.Nodes {
+			if login := strings.TrimSpace(n.Login); login != "" {
+				reaction.Users = append(reaction.Users, login)
+			}
+		}
+		reactions = append(reactions, reaction)
+	}
+	return reactions
+}
+
+// ReactionsTruncated reports whether any of reactions lists only some of
+// its users.
+func ReactionsTruncated(reactions []Reaction) bool {
+	for _, r := range reactions {
+		if r.Truncated {
+			return true
+		}
+	}
+	return false
+}
+
+// ReactedBy reports whether login is among the users listed in reactions.
+// Users past the first 20 of a kind are only seen once CompleteReactions
+// filled them in.
+func ReactedBy(reactions []Reaction, login string) bool {
+	for _, r := range reactions {
+		for _, u := range r.Users {
+			if strings.EqualFold(u, login) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CompleteReactions returns a comment's reactions with every user listed,
+// paging through all of its reactions. Reactions listing all of their users
+// already are returned as they are.
+func (c *Client) CompleteReactions(commentID string, reactions []Reaction) ([]Reaction, error) {
+	if !ReactionsTruncated(reactions) {
+		return reactions, nil
+	}
+	commentID, err := checkCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	const query = `query Reactors($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on Reactable {
+      reactions(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes { content user { login } }
+      }
+    }
+  }
+}`
+
+	users := make(map[string][]string)
+	cursor := ""
+	for {
+		variables := map[string]interface{}{
+			"id":    commentID,
+			"after": cursorVar(cursor),
+		}
+
+		var response struct {
+			Node struct {
+				Reactions struct {
+					PageInfo pageInfo `json:"pageInfo"`
+					Nodes    []struct {
+						Content string `json:"content"`
+						User    *struct {
+							Login string `json:"login"`
+						} `json:"user"`
+					} `json:"nodes"`
+				} `json:"reactions"`
+			} `json:"node"`
+		}
+
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, fmt.Errorf("query reactions: %w", err)
+		}
+
+		conn := response.Node.Reactions
+		for _, n := range conn.Nodes {
+			if n.User == nil || strings.TrimSpace(n.User.Login) == "" {
+				continue
+			}
+			name := reactionName(n.Content)
+			users[name] = append(users[name], strings.TrimSpace(n.User.Login))
+		}
+
+		next, more := conn.PageInfo.next()
+		if !more {
+			break
+		}
+		cursor = next
+	}
+
+	complete := make([]Reaction, len(reactions))
+	for i, r := range reactions {
+		r.Users, r.Truncated = users[r.Name], false
+		complete[i] = r
+	}
+	return complete, nil
+}
+
+// AddReaction reacts to a review or PR discussion comment; see Reactions.
+// Adding a reaction the viewer already left is not an error.
+func (c *Client) AddReaction(commentID, reaction string) error {
+	return c.setReaction(commentID, reaction, true)
+}
+
+// RemoveReaction takes back the viewer's reaction to a comment.
+func (c *Client) RemoveReaction(commentID, reaction string) error {
+	return c.setReaction(commentID, reaction, false)
+}
+
+func (c *Client) setReaction(commentID, reaction string, add bool) error {
+	commentID, err := checkCommentID(commentID)
+	if err != nil {
+		return err
+	}
+	name, err := ParseReaction(reaction)
+	if err != nil {
+		return err
+	}
+
+	field, verb := "addReaction", "add reaction"
+	if !add {
+		field, verb = "removeReaction", "remove reaction"
+	}
+	mutation := fmt.Sprintf(`mutation SetReaction($input: %sInput!) {
+  %s(input: $input) {
+    reaction { content }
+  }
+}`, strings.ToUpper(field[:1])+field[1:], field)
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"subjectId": commentID,
+			"content":   reactionContent(name),
+		},
+	}
+
+	var response map[string]struct {
+		Reaction struct {
+			Content string `json:"content"`
+		} `json:"reaction"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return fmt.Errorf("%s: %w", verb, err)
+	}
+
+	return nil
+}
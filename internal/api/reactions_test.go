@@ -0,0 +1,199 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseReaction(t *testing.T) {
+	tests := []struct {
+		reaction string
+		want     string
+		wantErr  bool
+	}{
+		{"+1", "+1", false},
+		{"THUMBS_UP", "+1", false},
+		{"👍", "+1", false},
+		{"Eyes", "eyes", false},
+		{"❤️", "heart", false},
+		{"❤", "heart", false},
+		{"thumbsup", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reaction, func(t *testing.T) {
+			got, err := ParseReaction(tt.reaction)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReaction(%q) error = %v, wantErr %v", tt.reaction, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseReaction(%q) = %q, want %q", tt.reaction, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReactableReactions(t *testing.T) {
+	var node reactable
+	data := `{"reactionGroups": [
+		{"content": "THUMBS_UP", "viewerHasReacted": true, "reactors": {"totalCount": 2, "nodes": [{"login": "octocat"}, {"login": "hubot"}]}},
+		{"content": "LAUGH", "viewerHasReacted": false, "reactors": {"totalCount": 0, "nodes": []}},
+		{"content": "EYES", "viewerHasReacted": false, "reactors": {"totalCount": 1, "nodes": [{}]}}
+	]}`
+	if err := json.Unmarshal([]byte(data), &node); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Reaction{
+		{Name: "+1", Count: 2, Viewer: true, Users: []string{"octocat", "hubot"}},
+		{Name: "eyes", Count: 1},
+	}
+	got := node.reactions()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("reactions() = %+v, want %+v", got, want)
+	}
+
+	if !ReactedBy(got, "OctoCat") {
+		t.Error("ReactedBy() = false for a listed reactor")
+	}
+	if ReactedBy(got, "someone") {
+		t.Error("ReactedBy() = true for a user who did not react")
+	}
+}
+
+func TestReactableTruncated(t *testing.T) {
+	var node reactable
+	data := `{"reactionGroups": [
+		{"content": "THUMBS_UP", "viewerHasReacted": false, "reactors": {"totalCount": 25, "nodes": [{"login": "a"}, {"login": "b"}]}},
+		{"content": "EYES", "viewerHasReacted": false, "reactors": {"totalCount": 1, "nodes": [{"login": "c"}]}}
+	]}`
+	if err := json.Unmarshal([]byte(data), &node); err != nil {
+		t.Fatal(err)
+	}
+
+	got := node.reactions()
+	if !got[0].Truncated || got[1].Truncated {
+		t.Errorf("reactions() = %+v, want only 👍 truncated", got)
+	}
+	if !ReactionsTruncated(got) || ReactionsTruncated(got[1:]) {
+		t.Error("ReactionsTruncated() should report the truncated group")
+	}
+}
+
+func TestClientCompleteReactions(t *testing.T) {
+	reactions := []Reaction{
+		{Name: "+1", Count: 3, Viewer: true, Users: []string{"a"}, Truncated: true},
+		{Name: "eyes", Count: 1, Users: []string{"c"}},
+	}
+
+	t.Run("pages through every reaction", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			calls++
+			if variables["id"] != "PRRC_1" {
+				t.Errorf("id = %v, want PRRC_1", variables["id"])
+			}
+			resp := `{"node": {"reactions": {"pageInfo": {"hasNextPage": true, "endCursor": "r2"}, "nodes": [
+				{"content": "THUMBS_UP", "user": {"login": "a"}},
+				{"content": "EYES", "user": {"login": "c"}}
+			]}}}`
+			if variables["after"] == "r2" {
+				resp = `{"node": {"reactions": {"pageInfo": {"hasNextPage": false}, "nodes": [
+					{"content": "THUMBS_UP", "user": {"login": "author"}},
+					{"content": "THUMBS_UP", "user": null}
+				]}}}`
+			}
+			return json.Unmarshal([]byte(resp), response)
+		})
+
+		got, err := client.CompleteReactions("PRRC_1", reactions)
+		if err != nil {
+			t.Fatalf("CompleteReactions() unexpected error: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("GraphQL calls = %d, want 2", calls)
+		}
+		if !ReactedBy(got, "author") || ReactionsTruncated(got) {
+			t.Errorf("CompleteReactions() = %+v, want every user listed", got)
+		}
+		if got[0].Count != 3 || !got[0].Viewer {
+			t.Errorf("CompleteReactions() = %+v, want counts and viewer kept", got[0])
+		}
+	})
+
+	t.Run("complete reactions need no query", func(t *testing.T) {
+		client := newTestClient(nil)
+		got, err := client.CompleteReactions("PRRC_1", reactions[1:])
+		if err != nil || !reflect.DeepEqual(got, reactions[1:]) {
+			t.Errorf("CompleteReactions() = %+v, %v", got, err)
+		}
+	})
+}
+
+func TestClientAddReaction(t *testing.T) {
+	t.Run("adds reaction", func(t *testing.T) {
+		var (
+			mutation string
+			input    map[string]interface{}
+		)
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			mutation = query
+			input = variables["input"].(map[string]interface{})
+			return json.Unmarshal([]byte(`{"addReaction": {"reaction": {"content": "THUMBS_UP"}}}`), response)
+		})
+
+		if err := client.AddReaction("PRRC_1", "+1"); err != nil {
+			t.Fatalf("AddReaction() unexpected error: %v", err)
+		}
+		if !strings.Contains(mutation, "addReaction(input: $input)") || !strings.Contains(mutation, "AddReactionInput!") {
+			t.Errorf("mutation = %q", mutation)
+		}
+		if input["subjectId"] != "PRRC_1" || input["content"] != "THUMBS_UP" {
+			t.Errorf("input = %v", input)
+		}
+	})
+
+	t.Run("invalid reaction", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.AddReaction("PRRC_1", "thumbsup"); err == nil {
+			t.Error("AddReaction() expected error for an unknown reaction")
+		}
+	})
+
+	t.Run("thread ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		if err := client.AddReaction("PRRT_1", "+1"); err == nil {
+			t.Error("AddReaction() expected error for a thread node ID")
+		}
+	})
+}
+
+func TestClientRemoveReaction(t *testing.T) {
+	t.Run("removes reaction", func(t *testing.T) {
+		var mutation string
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			mutation = query
+			return json.Unmarshal([]byte(`{"removeReaction": {"reaction": {"content": "EYES"}}}`), response)
+		})
+
+		if err := client.RemoveReaction("IC_1", "eyes"); err != nil {
+			t.Fatalf("RemoveReaction() unexpected error: %v", err)
+		}
+		if !strings.Contains(mutation, "removeReaction(input: $input)") || !strings.Contains(mutation, "RemoveReactionInput!") {
+			t.Errorf("mutation = %q", mutation)
+		}
+	})
+
+	t.Run("GraphQL error", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return errors.New("forbidden")
+		})
+		if err := client.RemoveReaction("IC_1", "eyes"); err == nil {
+			t.Error("RemoveReaction() expected error for GraphQL failure")
+		}
+	})
+}
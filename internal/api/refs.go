@@ -0,0 +1,122 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Ref is a reference to a review thread or comment as accepted by --thread
+// and --comment. Exactly one form is set:
+//
+//   - NodeID: a GraphQL node ID such as PRRT_… or PRRC_…
+//   - Thread: "t3", the third review thread of the PR
+//   - Thread and Comment: "c3.2", the second comment of thread 3
+//   - DatabaseID: "123456", "#discussion_r123456", or a comment URL
+//
+// Thread numbers follow the order GitHub lists threads in, which is the order
+// they were started, so they stay the same as threads are added or resolved.
+// PRNumber and Repo are set when the reference was a URL.
+type Ref struct {
+	NodeID     string
+	Thread     int
+	Comment    int
+	DatabaseID int64
+
+	PRNumber int
+	Repo     string
+}
+
+var (
+	threadRefPattern     = regexp.MustCompile(`^[tT](\d+)$`)
+	commentRefPattern    = regexp.MustCompile(`^[cC](\d+)\.(\d+)$`)
+	discussionRefPattern = regexp.MustCompile(`^#?(?:discussion_)?r(\d+)$`)
+	commentURLPattern    = regexp.MustCompile(`#(?:discussion_)?r(\d+)$`)
+)
+
+// ThreadRef returns the short reference of the n-th thread.
+func ThreadRef(n int) string {
+	return fmt.Sprintf("t%d", n)
+}
+
+// CommentRef returns the short reference of the m-th comment of thread n.
+func CommentRef(n, m int) string {
+	return fmt.Sprintf("c%d.%d", n, m)
+}
+
+// ParseRef parses a thread or comment reference.
+func ParseRef(s string) (Ref, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Ref{}, fmt.Errorf("empty reference")
+	}
+
+	if m := prURLPattern.FindStringSubmatch(s); m != nil {
+		id := commentURLPattern.FindStringSubmatch(s)
+		if id == nil {
+			return Ref{}, fmt.Errorf("invalid reference %q: URL does not point at a review comment", s)
+		}
+		number, _ := strconv.Atoi(m[3])
+		return Ref{DatabaseID: parseDatabaseID(id[1]), PRNumber: number, Repo: m[1] + "/" + m[2]}, nil
+	}
+	if m := threadRefPattern.FindStringSubmatch(s); m != nil {
+		return Ref{Thread: atoiRef(m[1])}, nil
+	}
+	if m := commentRefPattern.FindStringSubmatch(s); m != nil {
+		return Ref{Thread: atoiRef(m[1]), Comment: atoiRef(m[2])}, nil
+	}
+	if m := discussionRefPattern.FindStringSubmatch(s); m != nil {
+		return Ref{DatabaseID: parseDatabaseID(m[1])}, nil
+	}
+	if id, err := strconv.ParseInt(s, 10, 64); err == nil && id > 0 {
+		return Ref{DatabaseID: id}, nil
+	}
+	if !strings.ContainsAny(s, " \t#") {
+		return Ref{NodeID: s}, nil
+	}
+	return Ref{}, fmt.Errorf("invalid reference %q: expected a node ID, t<n>, c<n>.<m>, a comment ID or URL", s)
+}
+
+func atoiRef(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func parseDatabaseID(s string) int64 {
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+// IsComment reports whether r names a comment rather than a whole thread.
+// Node IDs name whichever kind they are.
+func (r Ref) IsComment() bool {
+	return r.Comment > 0 || r.DatabaseID > 0 || strings.HasPrefix(r.NodeID, "PRRC_")
+}
+
+// Find returns the thread r refers to and, for comment references, the
+// comment. Threads must be the PR's full thread list for numbered
+// references to resolve. It returns nil when nothing matches.
+func (r Ref) Find(threads []*Thread) (*Thread, *ThreadComment) {
+	for _, t := range threads {
+		switch {
+		case r.NodeID != "" && t.ID == r.NodeID:
+			return t, nil
+		case r.Thread > 0 && t.Number == r.Thread:
+			if r.Comment == 0 {
+				return t, nil
+			}
+			if r.Comment <= len(t.Comments) {
+				return t, t.Comments[r.Comment-1]
+			}
+			return nil, nil
+		}
+
+		for _, c := range t.Comments {
+			if (r.NodeID != "" && c.ID == r.NodeID) || (r.DatabaseID > 0 && c.DatabaseID == r.DatabaseID) {
+				return t, c
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    Ref
+		wantErr bool
+	}{
+		{"thread node ID", "PRRT_kwDOabc", Ref{NodeID: "PRRT_kwDOabc"}, false},
+		{"comment node ID", " PRRC_kwDOabc ", Ref{NodeID: "PRRC_kwDOabc"}, false},
+		{"thread number", "t3", Ref{Thread: 3}, false},
+		{"upper-case thread number", "T3", Ref{Thread: 3}, false},
+		{"comment number", "c3.2", Ref{Thread: 3, Comment: 2}, false},
+		{"database ID", "123456", Ref{DatabaseID: 123456}, false},
+		{"discussion fragment", "#discussion_r123456", Ref{DatabaseID: 123456}, false},
+		{"discussion fragment without hash", "discussion_r123456", Ref{DatabaseID: 123456}, false},
+		{"files view fragment", "#r123456", Ref{DatabaseID: 123456}, false},
+		{
+			"comment URL",
+			"https://github.com/owner/repo/pull/7#discussion_r123456",
+			Ref{DatabaseID: 123456, PRNumber: 7, Repo: "owner/repo"},
+			false,
+		},
+		{
+			"files view comment URL",
+			"https://github.com/owner/repo/pull/7/files#r99",
+			Ref{DatabaseID: 99, PRNumber: 7, Repo: "owner/repo"},
+			false,
+		},
+		{"PR URL without comment", "https://github.com/owner/repo/pull/7", Ref{}, true},
+		{"empty", "  ", Ref{}, true},
+		{"malformed", "#c3", Ref{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRef(tt.arg)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseRef(%q) = %+v, want error", tt.arg, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRef(%q) unexpected error: %v", tt.arg, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRef(%q) = %+v, want %+v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefFind(t *testing.T) {
+	threads := []*Thread{
+		{ID: "PRRT_a", Number: 1, Comments: []*ThreadComment{{ID: "PRRC_1", DatabaseID: 11}}},
+		{ID: "PRRT_b", Number: 3, Comments: []*ThreadComment{{ID: "PRRC_2", DatabaseID: 22}, {ID: "PRRC_3", DatabaseID: 33}}},
+	}
+
+	tests := []struct {
+		name        string
+		ref         Ref
+		wantThread  string
+		wantComment string
+	}{
+		{"thread node ID", Ref{NodeID: "PRRT_b"}, "PRRT_b", ""},
+		{"comment node ID", Ref{NodeID: "PRRC_3"}, "PRRT_b", "PRRC_3"},
+		{"thread number", Ref{Thread: 3}, "PRRT_b", ""},
+		{"comment number", Ref{Thread: 3, Comment: 2}, "PRRT_b", "PRRC_3"},
+		{"database ID", Ref{DatabaseID: 11}, "PRRT_a", "PRRC_1"},
+		{"comment number out of range", Ref{Thread: 1, Comment: 2}, "", ""},
+		{"unknown thread number", Ref{Thread: 2}, "", ""},
+		{"unknown database ID", Ref{DatabaseID: 44}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thread, comment := tt.ref.Find(threads)
+			gotThread, gotComment := "", ""
+			if thread != nil {
+				gotThread = thread.ID
+			}
+			if comment != nil {
+				gotComment = comment.ID
+			}
+			if gotThread != tt.wantThread || gotComment != tt.wantComment {
+				t.Errorf("Find() = %q, %q; want %q, %q", gotThread, gotComment, tt.wantThread, tt.wantComment)
+			}
+		})
+	}
+}
+
+func TestShortRefs(t *testing.T) {
+	if got := ThreadRef(3); got != "t3" {
+		t.Errorf("ThreadRef(3) = %q, want t3", got)
+	}
+	if got := CommentRef(3, 2); got != "c3.2" {
+		t.Errorf("CommentRef(3, 2) = %q, want c3.2", got)
+	}
+}
@@ -26,6 +26,44 @@ func TestClientReplyThread(t *testing.T) {
 		}
 	})
 
+	t.Run("draft reply into pending review", func(t *testing.T) {
+		var input map[string]interface{}
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			input = variables["input"].(map[string]interface{})
+			return json.Unmarshal([]byte(`{"addPullRequestReviewThreadReply": {"comment": {"id": "PRRC_new", "url": ""}}}`), response)
+		})
+
+		if _, err := client.ReplyThread(ReplyThreadInput{ThreadID: "PRRT_1", ReviewID: "PRR_1", Body: "Later"}); err != nil {
+			t.Fatalf("ReplyThread() unexpected error: %v", err)
+		}
+		if input["pullRequestReviewId"] != "PRR_1" {
+			t.Errorf("pullRequestReviewId = %v, want PRR_1", input["pullRequestReviewId"])
+		}
+	})
+
+	t.Run("immediate reply omits review", func(t *testing.T) {
+		var input map[string]interface{}
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			input = variables["input"].(map[string]interface{})
+			return json.Unmarshal([]byte(`{"addPullRequestReviewThreadReply": {"comment": {"id": "PRRC_new", "url": ""}}}`), response)
+		})
+
+		if _, err := client.ReplyThread(ReplyThreadInput{ThreadID: "PRRT_1", Body: "Now"}); err != nil {
+			t.Fatalf("ReplyThread() unexpected error: %v", err)
+		}
+		if _, ok := input["pullRequestReviewId"]; ok {
+			t.Error("pullRequestReviewId should be omitted without a review")
+		}
+	})
+
+	t.Run("invalid review ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		_, err := client.ReplyThread(ReplyThreadInput{ThreadID: "PRRT_1", ReviewID: "PRRT_2", Body: "hi"})
+		if err == nil {
+			t.Error("ReplyThread() expected error for non-review node ID")
+		}
+	})
+
 	t.Run("empty thread ID", func(t *testing.T) {
 		client := newTestClient(nil)
 		_, err := client.ReplyThread(ReplyThreadInput{ThreadID: "", Body: "hi"})
@@ -119,6 +157,54 @@ func TestClientResolveThread(t *testing.T) {
 	})
 }
 
+func TestClientUnresolveThread(t *testing.T) {
+	t.Run("successful unresolve", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			if !strings.Contains(query, "unresolveReviewThread") {
+				t.Errorf("query = %q, want unresolveReviewThread mutation", query)
+			}
+			return json.Unmarshal([]byte(`{"unresolveReviewThread": {"thread": {"id": "PRRT_1", "isResolved": false}}}`), response)
+		})
+
+		result, err := client.UnresolveThread("PRRT_1")
+		if err != nil {
+			t.Fatalf("UnresolveThread() unexpected error: %v", err)
+		}
+		if result.IsResolved {
+			t.Error("result.IsResolved = true, want false")
+		}
+		if result.ThreadID != "PRRT_1" {
+			t.Errorf("result.ThreadID = %q, want %q", result.ThreadID, "PRRT_1")
+		}
+	})
+
+	t.Run("empty thread ID", func(t *testing.T) {
+		client := newTestClient(nil)
+		_, err := client.UnresolveThread("  ")
+		if err == nil {
+			t.Error("UnresolveThread() expected error for empty thread ID")
+		}
+	})
+
+	t.Run("invalid thread ID format", func(t *testing.T) {
+		client := newTestClient(nil)
+		_, err := client.UnresolveThread("PRRC_notathread")
+		if err == nil {
+			t.Error("UnresolveThread() expected error for non-thread node ID")
+		}
+	})
+
+	t.Run("GraphQL error", func(t *testing.T) {
+		client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+			return errors.New("mutation failed")
+		})
+		_, err := client.UnresolveThread("PRRT_1")
+		if err == nil {
+			t.Error("UnresolveThread() expected error for GraphQL failure")
+		}
+	})
+}
+
 func TestClientThreadIDByComment(t *testing.T) {
 	threadsResp := `{
 		"repository": {
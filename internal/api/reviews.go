@@ -0,0 +1,385 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Review is a review of a PR. State is lowercase: approved,
+// changes_requested, commented, dismissed, or pending for the viewer's own
+// unsubmitted review, whose SubmittedAt is zero. CommitOID is the head
+// commit the review was made on. ByBot marks reviews by apps, which cannot
+// be requested to review again.
+type Review struct {
+	ID           string
+	Author       string
+	ByBot        bool
+	State        string
+	Body         string
+	SubmittedAt  time.Time
+	CommentCount int
+	CommitOID    string
+	URL          string
+}
+
+// Reviews lists every review of the PR in the order they were created.
+func (c *Client) Reviews(pr *PRRef) ([]*Review, error) {
+	const query = `query Reviews($owner: String!, $name: String!, $number: Int!, $first: Int!, $after: String) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      reviews(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id
+          state
+          body
+          url
+          submittedAt
+          author { login __typename }
+          commit { oid }
+          comments { totalCount }
+        }
+      }
+    }
+  }
+}`
+
+	var reviews []*Review
+	cursor := ""
+	for {
+		variables := map[string]interface{}{
+			"owner":  pr.Owner,
+			"name":   pr.Repo,
+			"number": pr.Number,
+			"first":  pageSize,
+			"after":  cursorVar(cursor),
+		}
+
+		var response struct {
+			Repository struct {
+				PullRequest struct {
+					Reviews struct {
+						PageInfo pageInfo `json:"pageInfo"`
+						Nodes    []struct {
+							ID          string  `json:"id"`
+							State       string  `json:"state"`
+							Body        string  `json:"body"`
+							URL         string  `json:"url"`
+							SubmittedAt *string `json:"submittedAt"`
+							Author      struct {
+								Login    string `json:"login"`
+								TypeName string `json:"__typename"`
+							} `json:"author"`
+							Commit *struct {
+								OID string `json:"oid"`
+							} `json:"commit"`
+							Comments struct {
+								TotalCount int `json:"totalCount"`
+							} `json:"comments"`
+						} `json:"nodes"`
+					} `json:"reviews"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
+
+		if err := c.gql.Do(query, variables, &response); err != nil {
+			return nil, fmt.Errorf("query reviews: %w", err)
+		}
+
+		conn := response.Repository.PullRequest.Reviews
+		for _, node := range conn.Nodes {
+			id := strings.TrimSpace(node.ID)
+			if id == "" {
+				continue
+			}
+
+			review := &Review{
+				ID:           id,
+				Author:       strings.TrimSpace(node.Author.Login),
+				ByBot:        node.Author.TypeName == "Bot",
+				State:        strings.ToLower(node.State),
+				Body:         node.Body,
+				CommentCount: node.Comments.TotalCount,
+				URL:          node.URL,
+			}
+			if node.SubmittedAt != nil {
+				review.SubmittedAt, _ = time.Parse(time.RFC3339, *node.SubmittedAt)
+			}
+			if node.Commit != nil {
+				review.CommitOID = node.Commit.OID
+			}
+			reviews = append(reviews, review)
+		}
+
+		next, more := conn.PageInfo.next()
+		if !more {
+			return reviews, nil
+		}
+		cursor = next
+	}
+}
+
+// LatestReviews returns each reviewer's effective review, as GitHub counts
+// it towards merging: their latest approval, change request or dismissed
+// review, or their latest comment-only review when they never gave a
+// verdict. Pending reviews are skipped. Reviews of deleted accounts have
+// no author to tell them apart, so each counts on its own. The result is
+// ordered by submission time.
+func LatestReviews(reviews []*Review) []*Review {
+	latest := make(map[string]*Review)
+	var reviewers []string
+	for _, r := range reviews {
+		if r.State == "pending" {
+			continue
+		}
+		key := strings.ToLower(r.Author)
+		if key == "" {
+			// Logins cannot contain ":", so these never meet an author.
+			key = "id:" + r.ID
+		}
+		prev, seen := latest[key]
+		if !seen {
+			reviewers = append(reviewers, key)
+		}
+		if !seen || r.SubmittedAt.After(prev.SubmittedAt) && (r.State != "commented" || prev.State == "commented") {
+			latest[key] = r
+		}
+	}
+
+	result := make([]*Review, len(reviewers))
+	for i, key := range reviewers {
+		result[i] = latest[key]
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].SubmittedAt.Before(result[j].SubmittedAt)
+	})
+	return result
+}
+
+// DismissReviewResult is the dismissed review and its new state.
+type DismissReviewResult struct {
+	ID    string
+	State string
+}
+
+// DismissReview dismisses a submitted review, leaving message on the PR as
+// the reason.
+func (c *Client) DismissReview(reviewID, message string) (*DismissReviewResult, error) {
+	reviewID = strings.TrimSpace(reviewID)
+	if reviewID == "" {
+		return nil, fmt.Errorf("review ID required")
+	}
+	if !strings.HasPrefix(reviewID, "PRR_") {
+		return nil, fmt.Errorf("invalid review ID %q: expected GraphQL node ID", reviewID)
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return nil, fmt.Errorf("message required")
+	}
+
+	const mutation = `mutation DismissReview($input: DismissPullRequestReviewInput!) {
+  dismissPullRequestReview(input: $input) {
+    pullRequestReview {
+      id
+      state
+    }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"pullRequestReviewId": reviewID,
+			"message":             message,
+		},
+	}
+
+	var response struct {
+		DismissPullRequestReview struct {
+			PullRequestReview struct {
+				ID    string `json:"id"`
+				State string `json:"state"`
+			} `json:"pullRequestReview"`
+		} `json:"dismissPullRequestReview"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return nil, fmt.Errorf("dismiss review: %w", err)
+	}
+
+	review := response.DismissPullRequestReview.PullRequestReview
+	return &DismissReviewResult{
+		ID:    review.ID,
+		State: strings.ToLower(review.State),
+	}, nil
+}
+
+// RequestReviewsInput names the reviewers to request: users by login and
+// teams as "org/team-slug". Existing requests are kept.
+type RequestReviewsInput struct {
+	PRNodeID string
+	Users    []string
+	Teams    []string
+}
+
+// RequestReviews asks users and teams to review a PR, or to review it again
+// when they already did. It returns every reviewer whose review is
+// requested afterwards: user logins and "org/team" names.
+func (c *Client) RequestReviews(input RequestReviewsInput) ([]string, error) {
+	prNodeID := strings.TrimSpace(input.PRNodeID)
+	if prNodeID == "" {
+		return nil, fmt.Errorf("PR node ID required")
+	}
+	if len(input.Users) == 0 && len(input.Teams) == 0 {
+		return nil, fmt.Errorf("at least one reviewer required")
+	}
+
+	userIDs, teamIDs, err := c.reviewerIDs(input.Users, input.Teams)
+	if err != nil {
+		return nil, err
+	}
+
+	const mutation = `mutation RequestReviews($input: RequestReviewsInput!) {
+  requestReviews(input: $input) {
+    pullRequest {
+      reviewRequests(first: 100) {
+        nodes {
+          requestedReviewer {
+            ... on User { login }
+            ... on Team { slug organization { login } }
+          }
+        }
+      }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"pullRequestId": prNodeID,
+			"userIds":       userIDs,
+			"teamIds":       teamIDs,
+			"union":         true,
+		},
+	}
+
+	var response struct {
+		RequestReviews struct {
+			PullRequest struct {
+				ReviewRequests struct {
+					Nodes []struct {
+						RequestedReviewer struct {
+							Login        string `json:"login"`
+							Slug         string `json:"slug"`
+							Organization struct {
+								Login string `json:"login"`
+							} `json:"organization"`
+						} `json:"requestedReviewer"`
+					} `json:"nodes"`
+				} `json:"reviewRequests"`
+			} `json:"pullRequest"`
+		} `json:"requestReviews"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &response); err != nil {
+		return nil, fmt.Errorf("request reviews: %w", err)
+	}
+
+	var requested []string
+	for _, n := range response.RequestReviews.PullRequest.ReviewRequests.Nodes {
+		r := n.RequestedReviewer
+		switch {
+		case r.Login != "":
+			requested = append(requested, r.Login)
+		case r.Slug != "":
+			requested = append(requested, r.Organization.Login+"/"+r.Slug)
+		}
+	}
+	return requested, nil
+}
+
+// reviewerIDs looks up the node IDs of users and "org/team" teams in one
+// query.
+func (c *Client) reviewerIDs(users, teams []string) ([]string, []string, error) {
+	var (
+		params  []string
+		fields  []string
+		aliases []string
+	)
+	variables := make(map[string]interface{})
+	for i, login := range users {
+		login = strings.TrimPrefix(strings.TrimSpace(login), "@")
+		if login == "" {
+			return nil, nil, fmt.Errorf("empty reviewer login")
+		}
+		alias := fmt.Sprintf("u%d", i)
+		params = append(params, fmt.Sprintf("$%s: String!", alias))
+		fields = append(fields, fmt.Sprintf("%s: user(login: $%s) { id }", alias, alias))
+		variables[alias] = login
+		aliases = append(aliases, alias)
+	}
+	for i, team := range teams {
+		org, slug, ok := strings.Cut(strings.TrimPrefix(strings.TrimSpace(team), "@"), "/")
+		if !ok || org == "" || slug == "" {
+			return nil, nil, fmt.Errorf("invalid team %q: expected org/team", team)
+		}
+		alias := fmt.Sprintf("t%d", i)
+		params = append(params, fmt.Sprintf("$%sOrg: String!, $%sSlug: String!", alias, alias))
+		fields = append(fields, fmt.Sprintf("%s: organization(login: $%sOrg) { team(slug: $%sSlug) { id } }", alias, alias, alias))
+		variables[alias+"Org"] = org
+		variables[alias+"Slug"] = slug
+		aliases = append(aliases, alias)
+	}
+
+	query := fmt.Sprintf("query ReviewerIDs(%s) {\n  %s\n}", strings.Join(params, ", "), strings.Join(fields, "\n  "))
+
+	var response map[string]json.RawMessage
+	if err := c.gql.Do(query, variables, &response); err != nil {
+		return nil, nil, fmt.Errorf("look up reviewers: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(users))
+	for i, login := range users {
+		var user *struct {
+			ID string `json:"id"`
+		}
+		_ = json.Unmarshal(response[aliases[i]], &user)
+		if user == nil || user.ID == "" {
+			return nil, nil, fmt.Errorf("no user %q", login)
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	teamIDs := make([]string, 0, len(teams))
+	for i, team := range teams {
+		var org *struct {
+			Team *struct {
+				ID string `json:"id"`
+			} `json:"team"`
+		}
+		_ = json.Unmarshal(response[aliases[len(users)+i]], &org)
+		if org == nil || org.Team == nil || org.Team.ID == "" {
+			return nil, nil, fmt.Errorf("no team %q", team)
+		}
+		teamIDs = append(teamIDs, org.Team.ID)
+	}
+	return userIDs, teamIDs, nil
+}
+
+// Reviewers returns the users who submitted a review, in the order they
+// first did, leaving out pending reviews and bots.
+func Reviewers(reviews []*Review) []string {
+	seen := make(map[string]bool)
+	var reviewers []string
+	for _, r := range reviews {
+		key := strings.ToLower(r.Author)
+		if r.State == "pending" || r.ByBot || r.Author == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		reviewers = append(reviewers, r.Author)
+	}
+	return reviewers
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestClientReviews(t *testing.T) {
+	client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+		resp := `{"repository": {"pullRequest": {"reviews": {
+			"pageInfo": {"hasNextPage": false},
+			"nodes": [
+				{"id": "PRR_1", "state": "CHANGES_REQUESTED", "body": "Needs tests", "url": "https://example.com/r1",
+				 "submittedAt": "2026-03-01T10:00:00Z", "author": {"login": "octocat"}, "commit": {"oid": "abc123"}, "comments": {"totalCount": 3}},
+				{"id": "PRR_2", "state": "PENDING", "body": "", "submittedAt": null,
+				 "author": {"login": "me"}, "commit": null, "comments": {"totalCount": 1}},
+				{"id": "", "state": "COMMENTED"}
+			]
+		}}}}`
+		return json.Unmarshal([]byte(resp), response)
+	})
+
+	reviews, err := client.Reviews(&PRRef{Owner: "o", Repo: "r", Number: 1})
+	if err != nil {
+		t.Fatalf("Reviews() unexpected error: %v", err)
+	}
+	if len(reviews) != 2 {
+		t.Fatalf("Reviews() returned %d reviews, want 2", len(reviews))
+	}
+
+	first := reviews[0]
+	if first.State != "changes_requested" || first.Author != "octocat" || first.CommitOID != "abc123" || first.CommentCount != 3 {
+		t.Errorf("first review = %+v", first)
+	}
+	if want := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC); !first.SubmittedAt.Equal(want) {
+		t.Errorf("SubmittedAt = %v, want %v", first.SubmittedAt, want)
+	}
+	if pending := reviews[1]; pending.State != "pending" || !pending.SubmittedAt.IsZero() || pending.CommitOID != "" {
+		t.Errorf("pending review = %+v", pending)
+	}
+}
+
+func TestLatestReviews(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC) }
+	reviews := []*Review{
+		{ID: "PRR_1", Author: "alice", State: "changes_requested", SubmittedAt: at(1)},
+		{ID: "PRR_2", Author: "bob", State: "commented", SubmittedAt: at(2)},
+		{ID: "PRR_3", Author: "Alice", State: "commented", SubmittedAt: at(3)},
+		{ID: "PRR_4", Author: "carol", State: "approved", SubmittedAt: at(4)},
+		{ID: "PRR_5", Author: "bob", State: "commented", SubmittedAt: at(5)},
+		{ID: "PRR_6", Author: "carol", State: "dismissed", SubmittedAt: at(6)},
+		{ID: "PRR_7", Author: "dave", State: "pending"},
+		{ID: "PRR_8", State: "approved", SubmittedAt: at(7)},
+		{ID: "PRR_9", State: "changes_requested", SubmittedAt: at(8)},
+	}
+
+	got := LatestReviews(reviews)
+	want := []string{"PRR_1", "PRR_5", "PRR_6", "PRR_8", "PRR_9"}
+	if len(got) != len(want) {
+		t.Fatalf("LatestReviews() returned %d reviews, want %d", len(got), len(want))
+	}
+	for i, id := range want {
+		if got[i].ID != id {
+			t.Errorf("LatestReviews()[%d] = %s, want %s", i, got[i].ID, id)
+		}
+	}
+}
+
+func TestDismissReviewValidation(t *testing.T) {
+	client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+		t.Fatal("unexpected API call")
+		return nil
+	})
+
+	tests := []struct {
+		name     string
+		reviewID string
+		message  string
+	}{
+		{"missing review", "", "stale"},
+		{"comment ID", "PRRC_1", "stale"},
+		{"blank message", "PRR_1", "  "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.DismissReview(tt.reviewID, tt.message); err == nil {
+				t.Error("DismissReview() expected error")
+			}
+		})
+	}
+}
+
+func TestDismissReview(t *testing.T) {
+	client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+		input := variables["input"].(map[string]interface{})
+		if input["pullRequestReviewId"] != "PRR_1" || input["message"] != "Addressed" {
+			t.Errorf("input = %v", input)
+		}
+		return json.Unmarshal([]byte(`{"dismissPullRequestReview": {"pullRequestReview": {"id": "PRR_1", "state": "DISMISSED"}}}`), response)
+	})
+
+	result, err := client.DismissReview("PRR_1", " Addressed ")
+	if err != nil {
+		t.Fatalf("DismissReview() unexpected error: %v", err)
+	}
+	if result.ID != "PRR_1" || result.State != "dismissed" {
+		t.Errorf("DismissReview() = %+v", result)
+	}
+}
+
+func TestRequestReviews(t *testing.T) {
+	calls := 0
+	client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+		calls++
+		if calls == 1 {
+			if variables["u0"] != "octocat" || variables["t0Org"] != "acme" || variables["t0Slug"] != "backend" {
+				t.Errorf("lookup variables = %v", variables)
+			}
+			return json.Unmarshal([]byte(`{"u0": {"id": "U_1"}, "t0": {"team": {"id": "T_1"}}}`), response)
+		}
+
+		input := variables["input"].(map[string]interface{})
+		if ids := input["userIds"].([]string); len(ids) != 1 || ids[0] != "U_1" {
+			t.Errorf("userIds = %v", ids)
+		}
+		if ids := input["teamIds"].([]string); len(ids) != 1 || ids[0] != "T_1" {
+			t.Errorf("teamIds = %v", ids)
+		}
+		if input["union"] != true {
+			t.Error("requestReviews should keep existing requests")
+		}
+		return json.Unmarshal([]byte(`{"requestReviews": {"pullRequest": {"reviewRequests": {"nodes": [
+			{"requestedReviewer": {"login": "hubot"}},
+			{"requestedReviewer": {"login": "octocat"}},
+			{"requestedReviewer": {"slug": "backend", "organization": {"login": "acme"}}}
+		]}}}}`), response)
+	})
+
+	got, err := client.RequestReviews(RequestReviewsInput{
+		PRNodeID: "PR_1",
+		Users:    []string{"@octocat"},
+		Teams:    []string{"acme/backend"},
+	})
+	if err != nil {
+		t.Fatalf("RequestReviews() unexpected error: %v", err)
+	}
+	want := []string{"hubot", "octocat", "acme/backend"}
+	if len(got) != len(want) {
+		t.Fatalf("RequestReviews() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("RequestReviews()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRequestReviewsUnknownReviewer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input RequestReviewsInput
+		resp  string
+	}{
+		{"user", RequestReviewsInput{PRNodeID: "PR_1", Users: []string{"ghost"}}, `{"u0": null}`},
+		{"team", RequestReviewsInput{PRNodeID: "PR_1", Teams: []string{"acme/nope"}}, `{"t0": {"team": null}}`},
+		{"malformed team", RequestReviewsInput{PRNodeID: "PR_1", Teams: []string{"acme/"}}, `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+				if _, ok := variables["input"]; ok {
+					t.Fatal("requestReviews called despite unknown reviewer")
+				}
+				return json.Unmarshal([]byte(tt.resp), response)
+			})
+			if _, err := client.RequestReviews(tt.input); err == nil {
+				t.Error("RequestReviews() expected error")
+			}
+		})
+	}
+}
+
+func TestReviewers(t *testing.T) {
+	reviews := []*Review{
+		{Author: "alice", State: "changes_requested"},
+		{Author: "dependabot", ByBot: true, State: "commented"},
+		{Author: "bob", State: "pending"},
+		{Author: "Alice", State: "approved"},
+		{Author: "carol", State: "dismissed"},
+	}
+
+	got := Reviewers(reviews)
+	want := []string{"alice", "carol"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Reviewers() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SearchPR is an open pull request found by SearchPullRequests.
+type SearchPR struct {
+	Ref       *PRRef
+	Title     string
+	URL       string
+	Author    string
+	IsDraft   bool
+	UpdatedAt time.Time
+}
+
+// SearchPullRequests runs a GitHub search for pull requests, e.g.
+// "is:open review-requested:@me". "is:pr" is added to the query. Limit caps
+// the number of results; zero or less fetches every match GitHub serves.
+func (c *Client) SearchPullRequests(query string, limit int) ([]*SearchPR, error) {
+	const search = `query SearchPullRequests($query: String!, $first: Int!, $after: String) {
+  search(query: $query, type: ISSUE, first: $first, after: $after) {
+    pageInfo { hasNextPage endCursor }
+    nodes {
+      ... on PullRequest {
+        number
+        title
+        url
+        isDraft
+        updatedAt
+        author { login }
+        repository { name owner { login } }
+      }
+    }
+  }
+}`
+
+	query = strings.TrimSpace(query)
+	if !strings.Contains(query, "is:pr") {
+		query = strings.TrimSpace("is:pr " + query)
+	}
+
+	var prs []*SearchPR
+	cursor := ""
+	for {
+		variables := map[string]interface{}{
+			"query": query,
+			"first": pageFirst(limit, len(prs)),
+			"after": cursorVar(cursor),
+		}
+
+		var response struct {
+			Search struct {
+				PageInfo pageInfo `json:"pageInfo"`
+				Nodes    []struct {
+					Number    int    `json:"number"`
+					Title     string `json:"title"`
+					URL       string `json:"url"`
+					IsDraft   bool   `json:"isDraft"`
+					UpdatedAt string `json:"updatedAt"`
+					Author    struct {
+						Login string `json:"login"`
+					} `json:"author"`
+					Repository struct {
+						Name  string `json:"name"`
+						Owner struct {
+							Login string `json:"login"`
+						} `json:"owner"`
+					} `json:"repository"`
+				} `json:"nodes"`
+			} `json:"search"`
+		}
+
+		if err := c.gql.Do(search, variables, &response); err != nil {
+			return nil, fmt.Errorf("search pull requests: %w", err)
+		}
+
+		conn := response.Search
+		for _, node := range conn.Nodes {
+			// Issues match the search too but decode as empty nodes.
+			if node.Number == 0 {
+				continue
+			}
+			updatedAt, _ := time.Parse(time.RFC3339, node.UpdatedAt)
+			prs = append(prs, &SearchPR{
+				Ref: &PRRef{
+					Owner:  node.Repository.Owner.Login,
+					Repo:   node.Repository.Name,
+					Number: node.Number,
+				},
+				Title:     node.Title,
+				URL:       node.URL,
+				Author:    strings.TrimSpace(node.Author.Login),
+				IsDraft:   node.IsDraft,
+				UpdatedAt: updatedAt,
+			})
+			if limit > 0 && len(prs) == limit {
+				return prs, nil
+			}
+		}
+
+		next, more := conn.PageInfo.next()
+		if !more {
+			return prs, nil
+		}
+		cursor = next
+	}
+}
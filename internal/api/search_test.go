@@ -0,0 +1,40 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSearchPullRequests(t *testing.T) {
+	var gotQuery string
+	client := newTestClient(func(query string, variables map[string]interface{}, response interface{}) error {
+		gotQuery = variables["query"].(string)
+		resp := `{"search": {
+			"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+			"nodes": [
+				{"number": 7, "title": "Add cache", "url": "https://github.com/o/r/pull/7", "isDraft": true,
+				 "updatedAt": "2026-03-01T10:00:00Z", "author": {"login": "octocat"}, "repository": {"name": "r", "owner": {"login": "o"}}},
+				{},
+				{"number": 9, "title": "Fix typo", "repository": {"name": "other", "owner": {"login": "o"}}}
+			]
+		}}`
+		return json.Unmarshal([]byte(resp), response)
+	})
+
+	prs, err := client.SearchPullRequests("is:open review-requested:@me", 2)
+	if err != nil {
+		t.Fatalf("SearchPullRequests() unexpected error: %v", err)
+	}
+	if gotQuery != "is:pr is:open review-requested:@me" {
+		t.Errorf("query = %q", gotQuery)
+	}
+	if len(prs) != 2 {
+		t.Fatalf("SearchPullRequests() returned %d PRs, want 2", len(prs))
+	}
+	if first := prs[0]; first.Ref.String() != "o/r#7" || first.Author != "octocat" || !first.IsDraft || first.UpdatedAt.IsZero() {
+		t.Errorf("first PR = %+v", first)
+	}
+	if prs[1].Ref.String() != "o/other#9" {
+		t.Errorf("second PR = %s, want o/other#9", prs[1].Ref)
+	}
+}
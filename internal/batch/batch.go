@@ -0,0 +1,177 @@
+// Package batch parses files of review comments for adding in bulk.
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Record is a single comment to add. Body and Template are alternatives;
+// Template wins when both are set. FileLevel records comment on the whole
+// file and leave the line fields unset.
+type Record struct {
+	Path      string `json:"path" yaml:"path"`
+	Line      int    `json:"line" yaml:"line"`
+	StartLine int    `json:"start_line,omitempty" yaml:"start_line"`
+	Side      string `json:"side,omitempty" yaml:"side"`
+	StartSide string `json:"start_side,omitempty" yaml:"start_side"`
+	Body      string `json:"body,omitempty" yaml:"body"`
+	Template  string `json:"template,omitempty" yaml:"template"`
+	FileLevel bool   `json:"file_level,omitempty" yaml:"file_level"`
+}
+
+type Format string
+
+const (
+	FormatJSONL Format = "jsonl"
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+)
+
+// DetectFormat picks a format from the file extension, falling back to the
+// content: a JSON array starts with '[', JSONL records with '{', and
+// anything else is read as YAML. A .json file may hold either JSON form.
+func DetectFormat(name string, data []byte) Format {
+	trimmed := bytes.TrimSpace(data)
+	isArray := len(trimmed) > 0 && trimmed[0] == '['
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".jsonl", ".ndjson":
+		return FormatJSONL
+	case ".json":
+		if isArray {
+			return FormatJSON
+		}
+		return FormatJSONL
+	}
+
+	switch {
+	case isArray:
+		return FormatJSON
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		return FormatJSONL
+	}
+	return FormatYAML
+}
+
+// Parse reads all records from r. The name is used for format detection
+// and error messages; pass "-" for stdin.
+func Parse(r io.Reader, name string) ([]Record, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", name, err)
+	}
+
+	var records []Record
+	switch DetectFormat(name, data) {
+	case FormatJSONL:
+		records, err = parseJSONL(data)
+	case FormatJSON:
+		records, err = parseJSON(data)
+	default:
+		records, err = parseYAML(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no comments found in %s", name)
+	}
+	for i, rec := range records {
+		if err := rec.check(); err != nil {
+			return nil, fmt.Errorf("parse %s: record %d: %w", name, i+1, err)
+		}
+	}
+
+	return records, nil
+}
+
+// check rejects line fields on file-level records, which GitHub refuses.
+func (r Record) check() error {
+	if !r.FileLevel {
+		return nil
+	}
+	var fields []string
+	if r.Line != 0 {
+		fields = append(fields, "line")
+	}
+	if r.StartLine != 0 {
+		fields = append(fields, "start_line")
+	}
+	if r.Side != "" {
+		fields = append(fields, "side")
+	}
+	if r.StartSide != "" {
+		fields = append(fields, "start_side")
+	}
+	if len(fields) > 0 {
+		return fmt.Errorf("file_level comments take no %s", strings.Join(fields, ", "))
+	}
+	return nil
+}
+
+func parseJSONL(data []byte) ([]Record, error) {
+	var records []Record
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.DisallowUnknownFields()
+
+		var rec Record
+		if err := dec.Decode(&rec); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func parseJSON(data []byte) ([]Record, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var records []Record
+	if err := dec.Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func parseYAML(data []byte) ([]Record, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var records []Record
+	if err := dec.Decode(&records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
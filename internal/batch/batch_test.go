@@ -0,0 +1,131 @@
+package batch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+		want Format
+	}{
+		{"yaml extension", "comments.yaml", "", FormatYAML},
+		{"yml extension", "comments.YML", "", FormatYAML},
+		{"jsonl extension", "comments.jsonl", "", FormatJSONL},
+		{"ndjson extension", "comments.ndjson", "", FormatJSONL},
+		{"json array", "comments.json", "\n [{\"path\": \"a.go\"}]", FormatJSON},
+		{"json lines", "comments.json", `{"path": "a.go"}`, FormatJSONL},
+		{"stdin json array", "-", `[{"path": "a.go"}]`, FormatJSON},
+		{"stdin json object", "-", `  {"path": "a.go"}`, FormatJSONL},
+		{"stdin yaml list", "-", "- path: a.go\n", FormatYAML},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectFormat(tt.file, []byte(tt.data)); got != tt.want {
+				t.Errorf("DetectFormat(%q) = %q, want %q", tt.file, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJSONL(t *testing.T) {
+	input := `{"path": "main.go", "line": 10, "body": "Fix this"}
+
+{"path": "util.go", "line": 20, "start_line": 15, "side": "LEFT", "template": "perf"}
+{"path": "logo.png", "file_level": true, "body": "SVG?"}
+`
+
+	records, err := Parse(strings.NewReader(input), "comments.jsonl")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Parse() returned %d records, want 3", len(records))
+	}
+
+	if records[0].Path != "main.go" || records[0].Line != 10 || records[0].Body != "Fix this" {
+		t.Errorf("records[0] = %+v", records[0])
+	}
+	if records[1].StartLine != 15 || records[1].Side != "LEFT" || records[1].Template != "perf" {
+		t.Errorf("records[1] = %+v", records[1])
+	}
+	if !records[2].FileLevel || records[2].Line != 0 {
+		t.Errorf("records[2] = %+v, want a file-level record", records[2])
+	}
+}
+
+func TestParseJSONArray(t *testing.T) {
+	input := `[
+  {"path": "main.go", "line": 10, "body": "Fix this"},
+  {"path": "logo.png", "file_level": true, "body": "SVG?"}
+]`
+
+	records, err := Parse(strings.NewReader(input), "comments.json")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if len(records) != 2 || records[0].Line != 10 || !records[1].FileLevel {
+		t.Errorf("Parse() = %+v", records)
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	input := `- path: main.go
+  line: 10
+  body: |
+    Multi-line
+    body
+- path: util.go
+  line: 20
+  start_line: 18
+  template: security
+`
+
+	records, err := Parse(strings.NewReader(input), "-")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Parse() returned %d records, want 2", len(records))
+	}
+	if records[0].Body != "Multi-line\nbody\n" {
+		t.Errorf("records[0].Body = %q", records[0].Body)
+	}
+	if records[1].StartLine != 18 || records[1].Template != "security" {
+		t.Errorf("records[1] = %+v", records[1])
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string
+		input       string
+		errContains string
+	}{
+		{"malformed JSONL reports line", "c.jsonl", "{\"path\": \"a.go\", \"line\": 1}\n{bad\n", "line 2"},
+		{"unknown JSONL field", "c.jsonl", `{"path": "a.go", "lnie": 1}`, "lnie"},
+		{"unknown YAML field", "c.yaml", "- path: a.go\n  lnie: 1\n", "lnie"},
+		{"empty file", "c.jsonl", "\n\n", "no comments"},
+		{"empty YAML", "c.yaml", "", "no comments"},
+		{"unknown JSON array field", "c.json", `[{"path": "a.go", "lnie": 1}]`, "lnie"},
+		{"file-level start side", "c.jsonl", "{\"path\": \"a.go\", \"line\": 1, \"body\": \"x\"}\n{\"path\": \"b.png\", \"file_level\": true, \"start_side\": \"LEFT\"}\n", "record 2: file_level comments take no start_side"},
+		{"file-level lines", "c.yaml", "- path: b.png\n  file_level: true\n  line: 3\n  start_line: 1\n", "record 1: file_level comments take no line, start_line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input), tt.file)
+			if err == nil {
+				t.Fatal("Parse() expected error")
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error = %q, want containing %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
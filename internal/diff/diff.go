@@ -0,0 +1,391 @@
+// Package diff models the unified diff of a pull request so comment anchors
+// can be checked before they are sent to GitHub.
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Side string
+
+const (
+	Left  Side = "LEFT"
+	Right Side = "RIGHT"
+)
+
+// ParseSide normalizes a diff side, defaulting to RIGHT.
+func ParseSide(s string) (Side, error) {
+	switch Side(strings.ToUpper(strings.TrimSpace(s))) {
+	case "", Right:
+		return Right, nil
+	case Left:
+		return Left, nil
+	default:
+		return "", fmt.Errorf("invalid side %q: use LEFT or RIGHT", s)
+	}
+}
+
+type LineKind int
+
+const (
+	Context LineKind = iota
+	Added
+	Deleted
+)
+
+// Line is one line of a hunk. OldLine is zero for added lines and NewLine
+// is zero for deleted lines.
+type Line struct {
+	Kind    LineKind
+	OldLine int
+	NewLine int
+	Text    string
+}
+
+// Number returns the line's number on the given side, or zero when the
+// line does not exist there.
+func (l Line) Number(side Side) int {
+	if side == Left {
+		return l.OldLine
+	}
+	return l.NewLine
+}
+
+type Hunk struct {
+	Header   string
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []Line
+}
+
+// Contains reports whether line can be commented on from side.
+func (h *Hunk) Contains(side Side, line int) bool {
+	for _, l := range h.Lines {
+		if l.Number(side) == line {
+			return true
+		}
+	}
+	return false
+}
+
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParsePatch parses the hunks of a single file's patch as served by the
+// GitHub pull request files API.
+func ParsePatch(patch string) ([]Hunk, error) {
+	var hunks []Hunk
+	var current *Hunk
+	var oldLine, newLine int
+
+	for i, text := range strings.Split(patch, "\n") {
+		if strings.HasPrefix(text, "@@") {
+			m := hunkHeaderPattern.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("line %d: malformed hunk header %q", i+1, text)
+			}
+			hunks = append(hunks, Hunk{
+				Header:   text,
+				OldStart: atoi(m[1]),
+				OldLines: countOrOne(m[2]),
+				NewStart: atoi(m[3]),
+				NewLines: countOrOne(m[4]),
+			})
+			current = &hunks[len(hunks)-1]
+			oldLine, newLine = current.OldStart, current.NewStart
+			continue
+		}
+
+		if current == nil || text == "" {
+			continue
+		}
+
+		switch text[0] {
+		case ' ':
+			current.Lines = append(current.Lines, Line{Kind: Context, OldLine: oldLine, NewLine: newLine, Text: text[1:]})
+			oldLine++
+			newLine++
+		case '+':
+			current.Lines = append(current.Lines, Line{Kind: Added, NewLine: newLine, Text: text[1:]})
+			newLine++
+		case '-':
+			current.Lines = append(current.Lines, Line{Kind: Deleted, OldLine: oldLine, Text: text[1:]})
+			oldLine++
+		case '\\':
+			// "\ No newline at end of file"
+		default:
+			return nil, fmt.Errorf("line %d: unexpected diff line %q", i+1, text)
+		}
+	}
+
+	return hunks, nil
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func countOrOne(s string) int {
+	if s == "" {
+		return 1
+	}
+	return atoi(s)
+}
+
+// File is one changed file. HasPatch is false when GitHub omits the patch,
+// as it does for binary and very large files.
+type File struct {
+	Path         string
+	PreviousPath string
+	Status       string
+	HasPatch     bool
+	Hunks        []Hunk
+}
+
+// NewFile builds a File from the fields of the pull request files API.
+func NewFile(path, previousPath, status, patch string) (*File, error) {
+	f := &File{
+		Path:         path,
+		PreviousPath: previousPath,
+		Status:       status,
+		HasPatch:     patch != "",
+	}
+	if patch == "" {
+		return f, nil
+	}
+
+	hunks, err := ParsePatch(patch)
+	if err != nil {
+		return nil, fmt.Errorf("parse patch for %s: %w", path, err)
+	}
+	f.Hunks = hunks
+	return f, nil
+}
+
+// HunkFor returns the hunk containing line on side, or nil.
+func (f *File) HunkFor(side Side, line int) *Hunk {
+	for i := range f.Hunks {
+		if f.Hunks[i].Contains(side, line) {
+			return &f.Hunks[i]
+		}
+	}
+	return nil
+}
+
+// Excerpt renders the part of the hunk holding lines start through end on
+// side as unified diff text, with up to radius lines around them. Commented
+// lines are marked with ">". It returns nil when end is outside the diff.
+func (f *File) Excerpt(side Side, start, end, radius int) []string {
+	h := f.HunkFor(side, end)
+	if h == nil {
+		return nil
+	}
+	if start <= 0 || start > end {
+		start = end
+	}
+
+	first, last := -1, -1
+	for i, l := range h.Lines {
+		if n := l.Number(side); n >= start && n <= end {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	from, to := max(0, first-radius), min(len(h.Lines), last+radius+1)
+
+	lines := []string{h.Header}
+	for i := from; i < to; i++ {
+		l := h.Lines[i]
+		marker := " "
+		if i >= first && i <= last && l.Number(side) != 0 {
+			marker = ">"
+		}
+		prefix := " "
+		switch l.Kind {
+		case Added:
+			prefix = "+"
+		case Deleted:
+			prefix = "-"
+		}
+		lines = append(lines, marker+" "+prefix+l.Text)
+	}
+	return lines
+}
+
+// HunkTail returns a diff hunk as served with a review comment, cut to its
+// header and last n lines. GitHub ends such hunks at the commented line.
+func HunkTail(hunk string, n int) []string {
+	hunk = strings.TrimRight(hunk, "\n")
+	if hunk == "" {
+		return nil
+	}
+	lines := strings.Split(hunk, "\n")
+	if !strings.HasPrefix(lines[0], "@@") || len(lines)-1 <= n {
+		return lines
+	}
+	return append([]string{lines[0]}, lines[len(lines)-n:]...)
+}
+
+// LineRange renders the lines a comment spans, "start-end", or just end
+// for a single line or when start is unknown.
+func LineRange(start, end int) string {
+	if start > 0 && start != end {
+		return fmt.Sprintf("%d-%d", start, end)
+	}
+	return fmt.Sprintf("%d", end)
+}
+
+// Nearest returns the commentable line on side closest to line, preferring
+// the lower line on ties. It returns zero when the file has no such lines.
+func (f *File) Nearest(side Side, line int) int {
+	best, bestDist := 0, 0
+	for _, h := range f.Hunks {
+		for _, l := range h.Lines {
+			n := l.Number(side)
+			if n == 0 {
+				continue
+			}
+			dist := n - line
+			if dist < 0 {
+				dist = -dist
+			}
+			if best == 0 || dist < bestDist || (dist == bestDist && n < best) {
+				best, bestDist = n, dist
+			}
+		}
+	}
+	return best
+}
+
+type Diff struct {
+	Files []*File
+}
+
+// File returns the changed file at path, or nil.
+func (d *Diff) File(path string) *File {
+	for _, f := range d.Files {
+		if f.Path == path {
+			return f
+		}
+	}
+	return nil
+}
+
+// Paths returns the changed file paths in sorted order.
+func (d *Diff) Paths() []string {
+	paths := make([]string, len(d.Files))
+	for i, f := range d.Files {
+		paths[i] = f.Path
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Anchor is the position of a review comment. StartLine is zero for
+// single-line comments; empty sides default to RIGHT, and StartSide to Side.
+// FileLevel anchors comment on the whole file and ignore the line fields.
+type Anchor struct {
+	Path      string
+	Line      int
+	Side      string
+	StartLine int
+	StartSide string
+	FileLevel bool
+}
+
+// Validate checks that the anchor points into a commentable hunk, or for
+// file-level anchors that the file is changed, and explains how to fix it
+// when it does not.
+func (d *Diff) Validate(a Anchor) error {
+	if a.FileLevel {
+		if d.File(a.Path) == nil {
+			return d.unknownPathError(a.Path)
+		}
+		return nil
+	}
+
+	side, err := ParseSide(a.Side)
+	if err != nil {
+		return err
+	}
+	startSide := side
+	if a.StartSide != "" {
+		if startSide, err = ParseSide(a.StartSide); err != nil {
+			return err
+		}
+	}
+
+	f := d.File(a.Path)
+	if f == nil {
+		return d.unknownPathError(a.Path)
+	}
+	if !f.HasPatch {
+		return fmt.Errorf("%s has no line diff (binary or too large); comment on the whole file instead", a.Path)
+	}
+
+	end := f.HunkFor(side, a.Line)
+	if end == nil {
+		return outsideDiffError(f, side, a.Line)
+	}
+
+	if a.StartLine > 0 {
+		if a.StartLine > a.Line && startSide == side {
+			return fmt.Errorf("start line %d is after line %d", a.StartLine, a.Line)
+		}
+		start := f.HunkFor(startSide, a.StartLine)
+		if start == nil {
+			return outsideDiffError(f, startSide, a.StartLine)
+		}
+		if start != end {
+			return fmt.Errorf("lines %d-%d of %s span more than one diff hunk", a.StartLine, a.Line, a.Path)
+		}
+	}
+
+	return nil
+}
+
+func outsideDiffError(f *File, side Side, line int) error {
+	msg := fmt.Sprintf("line %d (%s) of %s is not part of the diff", line, side, f.Path)
+	if nearest := f.Nearest(side, line); nearest > 0 {
+		msg += fmt.Sprintf("; nearest commentable line is %d", nearest)
+	}
+	return errors.New(msg)
+}
+
+// maxListedPaths bounds the changed-file list included in errors.
+const maxListedPaths = 10
+
+func (d *Diff) unknownPathError(p string) error {
+	msg := fmt.Sprintf("%s is not changed in this pull request", p)
+
+	base := path.Base(p)
+	for _, f := range d.Files {
+		if path.Base(f.Path) == base {
+			return fmt.Errorf("%s; did you mean %s?", msg, f.Path)
+		}
+	}
+
+	paths := d.Paths()
+	if len(paths) == 0 {
+		return fmt.Errorf("%s; it has no changed files", msg)
+	}
+	listed := paths
+	if len(listed) > maxListedPaths {
+		listed = listed[:maxListedPaths]
+	}
+	msg += "; changed files: " + strings.Join(listed, ", ")
+	if more := len(paths) - len(listed); more > 0 {
+		msg += fmt.Sprintf(" (and %d more)", more)
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,247 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const samplePatch = `@@ -1,4 +1,6 @@
+ package main
+-import "fmt"
++import (
++	"fmt"
++)
+ 
+ func main() {
+@@ -20,3 +22,3 @@ func helper() {
+ 	a := 1
+-	b := 2
++	b := 3
+ 	return
+\ No newline at end of file`
+
+func sampleDiff(t *testing.T) *Diff {
+	t.Helper()
+	f, err := NewFile("main.go", "", "modified", samplePatch)
+	if err != nil {
+		t.Fatalf("NewFile() unexpected error: %v", err)
+	}
+	bin, _ := NewFile("logo.png", "", "added", "")
+	return &Diff{Files: []*File{f, bin}}
+}
+
+func TestParsePatch(t *testing.T) {
+	hunks, err := ParsePatch(samplePatch)
+	if err != nil {
+		t.Fatalf("ParsePatch() unexpected error: %v", err)
+	}
+	if len(hunks) != 2 {
+		t.Fatalf("ParsePatch() returned %d hunks, want 2", len(hunks))
+	}
+
+	first := hunks[0]
+	if first.OldStart != 1 || first.OldLines != 4 || first.NewStart != 1 || first.NewLines != 6 {
+		t.Errorf("first hunk range = -%d,%d +%d,%d", first.OldStart, first.OldLines, first.NewStart, first.NewLines)
+	}
+	if len(first.Lines) != 7 {
+		t.Fatalf("first hunk has %d lines, want 7", len(first.Lines))
+	}
+	deleted := first.Lines[1]
+	if deleted.Kind != Deleted || deleted.OldLine != 2 || deleted.NewLine != 0 {
+		t.Errorf("deleted line = %+v", deleted)
+	}
+	added := first.Lines[4]
+	if added.Kind != Added || added.NewLine != 4 || added.Text != ")" {
+		t.Errorf("added line = %+v", added)
+	}
+	last := first.Lines[6]
+	if last.Kind != Context || last.OldLine != 4 || last.NewLine != 6 {
+		t.Errorf("trailing context line = %+v", last)
+	}
+
+	second := hunks[1]
+	if len(second.Lines) != 4 {
+		t.Errorf("second hunk has %d lines, want 4 (no-newline marker ignored)", len(second.Lines))
+	}
+}
+
+func TestParsePatchErrors(t *testing.T) {
+	if _, err := ParsePatch("@@ bogus @@\n+x"); err == nil {
+		t.Error("ParsePatch() expected error for malformed header")
+	}
+	if _, err := ParsePatch("@@ -1 +1 @@\n?what"); err == nil {
+		t.Error("ParsePatch() expected error for unknown line prefix")
+	}
+}
+
+func TestParseSide(t *testing.T) {
+	for in, want := range map[string]Side{"": Right, "right": Right, "LEFT": Left} {
+		got, err := ParseSide(in)
+		if err != nil || got != want {
+			t.Errorf("ParseSide(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseSide("up"); err == nil {
+		t.Error("ParseSide(\"up\") expected error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	d := sampleDiff(t)
+
+	tests := []struct {
+		name        string
+		anchor      Anchor
+		errContains string
+	}{
+		{name: "added line on right", anchor: Anchor{Path: "main.go", Line: 3}},
+		{name: "context line on left", anchor: Anchor{Path: "main.go", Line: 20, Side: "LEFT"}},
+		{name: "deleted line on left", anchor: Anchor{Path: "main.go", Line: 2, Side: "left"}},
+		{name: "multi-line in one hunk", anchor: Anchor{Path: "main.go", StartLine: 2, Line: 5}},
+		{
+			name:        "line between hunks",
+			anchor:      Anchor{Path: "main.go", Line: 10},
+			errContains: "nearest commentable line is 6",
+		},
+		{
+			name:        "left line past last hunk",
+			anchor:      Anchor{Path: "main.go", Line: 30, Side: "LEFT"},
+			errContains: "line 30 (LEFT) of main.go is not part of the diff; nearest commentable line is 22",
+		},
+		{
+			name:        "range spans hunks",
+			anchor:      Anchor{Path: "main.go", StartLine: 5, Line: 23},
+			errContains: "more than one diff hunk",
+		},
+		{
+			name:        "start after end",
+			anchor:      Anchor{Path: "main.go", StartLine: 5, Line: 3},
+			errContains: "after line",
+		},
+		{
+			name:        "unchanged path lists files",
+			anchor:      Anchor{Path: "other.go", Line: 1},
+			errContains: "changed files: logo.png, main.go",
+		},
+		{
+			name:        "path suggestion by base name",
+			anchor:      Anchor{Path: "cmd/main.go", Line: 1},
+			errContains: "did you mean main.go?",
+		},
+		{
+			name:        "binary file",
+			anchor:      Anchor{Path: "logo.png", Line: 1},
+			errContains: "binary or too large",
+		},
+		{
+			name:        "invalid side",
+			anchor:      Anchor{Path: "main.go", Line: 3, Side: "middle"},
+			errContains: "invalid side",
+		},
+		{name: "file-level on binary file", anchor: Anchor{Path: "logo.png", FileLevel: true}},
+		{
+			name:        "file-level on unchanged path",
+			anchor:      Anchor{Path: "other.go", FileLevel: true},
+			errContains: "not changed in this pull request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := d.Validate(tt.anchor)
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Validate() expected error")
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error = %q, want containing %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
+
+func TestUnknownPathTruncatesList(t *testing.T) {
+	d := &Diff{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		f, _ := NewFile(name+".txt", "", "added", "@@ -0,0 +1 @@\n+x")
+		d.Files = append(d.Files, f)
+	}
+
+	err := d.Validate(Anchor{Path: "zzz/none.go", Line: 1})
+	if err == nil || !strings.Contains(err.Error(), "(and 2 more)") {
+		t.Errorf("Validate() error = %v, want truncated file list", err)
+	}
+}
+
+func TestFileExcerpt(t *testing.T) {
+	f := sampleDiff(t).File("main.go")
+
+	got := f.Excerpt(Right, 2, 3, 1)
+	want := []string{
+		"@@ -1,4 +1,6 @@",
+		"  -import \"fmt\"",
+		"> +import (",
+		"> +\t\"fmt\"",
+		"  +)",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Excerpt() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Excerpt()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if got := f.Excerpt(Right, 0, 12, 3); got != nil {
+		t.Errorf("Excerpt() outside the diff = %q, want nil", got)
+	}
+}
+
+func TestHunkTail(t *testing.T) {
+	hunk := "@@ -1,5 +1,5 @@\n a\n b\n-c\n+C\n d\n"
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{"keeps header and tail", 2, []string{"@@ -1,5 +1,5 @@", "+C", " d"}},
+		{"short hunk unchanged", 10, []string{"@@ -1,5 +1,5 @@", " a", " b", "-c", "+C", " d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HunkTail(hunk, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HunkTail() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := HunkTail("", 3); got != nil {
+		t.Errorf("HunkTail(\"\") = %q, want nil", got)
+	}
+}
+
+func TestLineRange(t *testing.T) {
+	tests := []struct {
+		start, end int
+		want       string
+	}{
+		{0, 12, "12"},
+		{12, 12, "12"},
+		{10, 12, "10-12"},
+	}
+
+	for _, tt := range tests {
+		if got := LineRange(tt.start, tt.end); got != tt.want {
+			t.Errorf("LineRange(%d, %d) = %q, want %q", tt.start, tt.end, got, tt.want)
+		}
+	}
+}
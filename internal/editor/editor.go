@@ -0,0 +1,120 @@
+// Package editor composes text in the user's editor, the way git commit and
+// gh pr create do.
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// scissors separates the body from the context below it. Everything from
+// this line on is dropped when the file is read back.
+const scissors = "# ------------------------ >8 ------------------------"
+
+// ErrEmpty is returned when the edited body is empty.
+var ErrEmpty = errors.New("aborting due to empty body")
+
+// Command returns the editor to run, taken from $GH_EDITOR, $VISUAL or
+// $EDITOR, falling back to a platform default.
+func Command() string {
+	for _, name := range []string{"GH_EDITOR", "VISUAL", "EDITOR"} {
+		if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+			return v
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// Scaffold returns the file the editor opens: body on top, then the context
+// lines commented out below a scissors line.
+func Scaffold(body string, context []string) string {
+	var b strings.Builder
+	b.WriteString(body)
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(scissors)
+	b.WriteString("\n# Do not modify or remove the line above.\n")
+	b.WriteString("# Everything below it will be ignored.\n")
+	for _, line := range context {
+		if line == "" {
+			b.WriteString("#\n")
+			continue
+		}
+		b.WriteString("# ")
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Strip returns the text above the scissors line with surrounding blank
+// space trimmed.
+func Strip(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if i := strings.Index(text, scissors); i >= 0 {
+		text = text[:i]
+	}
+	return strings.TrimSpace(text)
+}
+
+// Edit opens body and its context in the editor and returns the edited body,
+// or ErrEmpty if nothing is left.
+func Edit(body string, context []string) (string, error) {
+	f, err := os.CreateTemp("", "gh-review-*.md")
+	if err != nil {
+		return "", fmt.Errorf("create editor file: %w", err)
+	}
+	name := f.Name()
+	defer os.Remove(name)
+
+	if _, err := f.WriteString(Scaffold(body, context)); err != nil {
+		f.Close()
+		return "", fmt.Errorf("write editor file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("write editor file: %w", err)
+	}
+
+	if err := run(Command(), name); err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("read editor file: %w", err)
+	}
+
+	edited := Strip(string(data))
+	if edited == "" {
+		return "", ErrEmpty
+	}
+	return edited, nil
+}
+
+// run starts the editor on file through the shell, so editor commands with
+// arguments such as "code --wait" work.
+func run(editor, file string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/c", editor, file)
+	} else {
+		cmd = exec.Command("sh", "-c", editor+` "$1"`, "sh", file)
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run editor %q: %w", editor, err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package editor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	t.Setenv("GH_EDITOR", "")
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "nano")
+	if got := Command(); got != "nano" {
+		t.Errorf("Command() = %q, want $EDITOR", got)
+	}
+
+	t.Setenv("VISUAL", "code --wait")
+	if got := Command(); got != "code --wait" {
+		t.Errorf("Command() = %q, want $VISUAL over $EDITOR", got)
+	}
+
+	t.Setenv("GH_EDITOR", "hx")
+	if got := Command(); got != "hx" {
+		t.Errorf("Command() = %q, want $GH_EDITOR first", got)
+	}
+}
+
+func TestScaffoldStrip(t *testing.T) {
+	context := []string{"main.go:42 (RIGHT)", "", "@@ -40,3 +40,3 @@", "> +\treturn nil"}
+	scaffold := Scaffold("## Summary\nLooks good", context)
+
+	if !strings.HasPrefix(scaffold, "## Summary\nLooks good\n") {
+		t.Errorf("scaffold should start with the body, got %q", scaffold)
+	}
+	if !strings.Contains(scaffold, "# main.go:42 (RIGHT)\n#\n# @@ -40,3 +40,3 @@\n") {
+		t.Errorf("scaffold should comment out the context, got %q", scaffold)
+	}
+
+	// Markdown headings above the scissors line survive.
+	if got := Strip(scaffold); got != "## Summary\nLooks good" {
+		t.Errorf("Strip() = %q", got)
+	}
+	if got := Strip(Scaffold("", context)); got != "" {
+		t.Errorf("Strip() of an untouched empty scaffold = %q, want empty", got)
+	}
+	if got := Strip("  just text\r\n"); got != "just text" {
+		t.Errorf("Strip() without scissors = %q", got)
+	}
+}
+
+func TestEdit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("editor script requires sh")
+	}
+
+	script := func(t *testing.T, body string) {
+		t.Helper()
+		name := filepath.Join(t.TempDir(), "editor.sh")
+		content := "#!/bin/sh\n" + body + "\n"
+		if err := os.WriteFile(name, []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("GH_EDITOR", name)
+	}
+
+	t.Run("returns the body above the scissors line", func(t *testing.T) {
+		script(t, `{ printf 'Rewritten\n'; cat "$1"; } > "$1.new" && mv "$1.new" "$1"`)
+		got, err := Edit("Original", []string{"main.go:1"})
+		if err != nil {
+			t.Fatalf("Edit() unexpected error: %v", err)
+		}
+		if got != "Rewritten\nOriginal" {
+			t.Errorf("Edit() = %q", got)
+		}
+	})
+
+	t.Run("empty body aborts", func(t *testing.T) {
+		script(t, `: > "$1"`)
+		_, err := Edit("", nil)
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("Edit() error = %v, want ErrEmpty", err)
+		}
+	})
+
+	t.Run("editor failure", func(t *testing.T) {
+		script(t, "exit 1")
+		_, err := Edit("body", nil)
+		if err == nil || errors.Is(err, ErrEmpty) {
+			t.Errorf("Edit() error = %v, want the editor's failure", err)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package findings
+
+import "encoding/xml"
+
+type checkstyleReport struct {
+	Files []struct {
+		Name   string `xml:"name,attr"`
+		Errors []struct {
+			Line     int    `xml:"line,attr"`
+			Severity string `xml:"severity,attr"`
+			Message  string `xml:"message,attr"`
+			Source   string `xml:"source,attr"`
+		} `xml:"error"`
+	} `xml:"file"`
+}
+
+// parseCheckstyle reads checkstyle XML. Errors without a line are skipped.
+func parseCheckstyle(data []byte) ([]Finding, error) {
+	var report checkstyleReport
+	if err := xml.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, file := range report.Files {
+		for _, e := range file.Errors {
+			if file.Name == "" || e.Line <= 0 {
+				continue
+			}
+			findings = append(findings, Finding{
+				Path:     file.Name,
+				Line:     e.Line,
+				Message:  e.Message,
+				Rule:     e.Source,
+				Severity: e.Severity,
+			})
+		}
+	}
+
+	return findings, nil
+}
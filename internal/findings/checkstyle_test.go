@@ -0,0 +1,30 @@
+package findings
+
+import "testing"
+
+func TestParseCheckstyle(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="5.0">
+  <file name="pkg/a.go">
+    <error line="12" column="3" severity="warning" message="exported func missing doc" source="revive"></error>
+    <error column="1" severity="error" message="no line" source="x"></error>
+  </file>
+  <file name="pkg/b.go">
+    <error line="4" severity="error" message="shadowed" source="govet"></error>
+  </file>
+</checkstyle>`
+
+	got, err := parseCheckstyle([]byte(input))
+	if err != nil {
+		t.Fatalf("parseCheckstyle() unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("parseCheckstyle() returned %d findings, want 2", len(got))
+	}
+	if got[0].Path != "pkg/a.go" || got[0].Line != 12 || got[0].Rule != "revive" {
+		t.Errorf("first finding = %+v", got[0])
+	}
+	if got[1].Path != "pkg/b.go" || got[1].Severity != "error" {
+		t.Errorf("second finding = %+v", got[1])
+	}
+}
@@ -0,0 +1,167 @@
+// Package findings parses static analysis reports (SARIF, checkstyle,
+// reviewdog rdjson/rdjsonl) into review comment candidates.
+package findings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// Finding is a single analyzer result anchored to a file region.
+// StartLine is zero for single-line findings.
+type Finding struct {
+	Path      string
+	StartLine int
+	Line      int
+	Message   string
+	Rule      string
+	Severity  string
+	Tool      string
+}
+
+// Body renders the finding as a review comment body.
+func (f Finding) Body() string {
+	var b strings.Builder
+	if f.Rule != "" {
+		fmt.Fprintf(&b, "**%s**: ", f.Rule)
+	}
+	b.WriteString(strings.TrimSpace(f.Message))
+	if f.Tool != "" {
+		fmt.Fprintf(&b, "\n\n_Reported by %s_", f.Tool)
+	}
+	return b.String()
+}
+
+type Format string
+
+const (
+	FormatSARIF      Format = "sarif"
+	FormatCheckstyle Format = "checkstyle"
+	FormatRDJSON     Format = "rdjson"
+	FormatRDJSONL    Format = "rdjsonl"
+)
+
+// ParseFormat validates a user-supplied format name. An empty name means
+// auto-detect and returns "".
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case "", FormatSARIF, FormatCheckstyle, FormatRDJSON, FormatRDJSONL:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown report format %q: use sarif, checkstyle, rdjson, or rdjsonl", s)
+	}
+}
+
+// DetectFormat picks a format from the file extension, falling back to the
+// document's shape.
+func DetectFormat(name string, data []byte) (Format, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".sarif":
+		return FormatSARIF, nil
+	case ".xml":
+		return FormatCheckstyle, nil
+	case ".rdjson":
+		return FormatRDJSON, nil
+	case ".rdjsonl":
+		return FormatRDJSONL, nil
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return "", fmt.Errorf("empty report")
+	}
+	if trimmed[0] == '<' {
+		return FormatCheckstyle, nil
+	}
+
+	var probe struct {
+		Runs        json.RawMessage `json:"runs"`
+		Diagnostics json.RawMessage `json:"diagnostics"`
+	}
+	if err := json.Unmarshal(trimmed, &probe); err != nil {
+		// Not a single JSON document: assume one diagnostic per line.
+		return FormatRDJSONL, nil
+	}
+	switch {
+	case probe.Runs != nil:
+		return FormatSARIF, nil
+	case probe.Diagnostics != nil:
+		return FormatRDJSON, nil
+	default:
+		return FormatRDJSONL, nil
+	}
+}
+
+// Parse reads all findings from r. When format is empty it is detected from
+// name and content.
+func Parse(r io.Reader, name string, format Format) ([]Finding, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", name, err)
+	}
+
+	if format == "" {
+		format, err = DetectFormat(name, data)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", name, err)
+		}
+	}
+
+	var findings []Finding
+	switch format {
+	case FormatSARIF:
+		findings, err = parseSARIF(data)
+	case FormatCheckstyle:
+		findings, err = parseCheckstyle(data)
+	case FormatRDJSON:
+		findings, err = parseRDJSON(data)
+	case FormatRDJSONL:
+		findings, err = parseRDJSONL(data)
+	default:
+		err = fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s as %s: %w", name, format, err)
+	}
+
+	return findings, nil
+}
+
+// RelativePath converts a report path or file URI into a slash-separated
+// path relative to root. Paths outside root are returned cleaned but
+// otherwise unchanged.
+func RelativePath(p, root string) string {
+	p = strings.TrimSpace(p)
+	if u, err := url.Parse(p); err == nil && u.Scheme == "file" {
+		p = u.Path
+	}
+
+	p = filepath.FromSlash(p)
+	if filepath.IsAbs(p) && root != "" {
+		if rel, err := filepath.Rel(root, p); err == nil && !outside(rel) {
+			p = rel
+		}
+	}
+
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(p)), "./")
+}
+
+// outside reports whether a path relative to a root leaves it. Names that
+// merely start with dots, such as "..foo", stay inside.
+func outside(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// lineRange orders a region into the StartLine/Line pair used by review
+// threads, dropping StartLine for single-line regions.
+func lineRange(start, end int) (int, int) {
+	if end <= start {
+		return 0, start
+	}
+	return start, end
+}
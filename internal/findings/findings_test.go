@@ -0,0 +1,113 @@
+package findings
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindingBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		finding Finding
+		want    string
+	}{
+		{
+			name:    "message only",
+			finding: Finding{Message: "  unused variable  "},
+			want:    "unused variable",
+		},
+		{
+			name:    "rule and tool",
+			finding: Finding{Message: "error not checked", Rule: "errcheck", Tool: "golangci-lint"},
+			want:    "**errcheck**: error not checked\n\n_Reported by golangci-lint_",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.finding.Body(); got != tt.want {
+				t.Errorf("Body() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, s := range []string{"", "sarif", "SARIF", "checkstyle", "rdjson", "rdjsonl"} {
+		if _, err := ParseFormat(s); err != nil {
+			t.Errorf("ParseFormat(%q) unexpected error: %v", s, err)
+		}
+	}
+	if _, err := ParseFormat("junit"); err == nil {
+		t.Error("ParseFormat(\"junit\") expected error")
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+		want Format
+	}{
+		{"sarif extension", "out.sarif", "", FormatSARIF},
+		{"xml extension", "lint.xml", "", FormatCheckstyle},
+		{"rdjsonl extension", "out.rdjsonl", "", FormatRDJSONL},
+		{"sniff xml", "-", `<?xml version="1.0"?><checkstyle/>`, FormatCheckstyle},
+		{"sniff sarif", "-", `{"version": "2.1.0", "runs": []}`, FormatSARIF},
+		{"sniff rdjson", "-", `{"diagnostics": []}`, FormatRDJSON},
+		{"sniff rdjsonl", "-", "{\"message\": \"a\"}\n{\"message\": \"b\"}\n", FormatRDJSONL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectFormat(tt.file, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("DetectFormat() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := DetectFormat("-", []byte("  ")); err == nil {
+		t.Error("DetectFormat() expected error for empty input")
+	}
+}
+
+func TestParseWrapsErrors(t *testing.T) {
+	_, err := Parse(strings.NewReader("{not json"), "out.sarif", "")
+	if err == nil {
+		t.Fatal("Parse() expected error for malformed SARIF")
+	}
+	if !strings.Contains(err.Error(), "out.sarif") {
+		t.Errorf("error = %q, want containing file name", err.Error())
+	}
+}
+
+func TestRelativePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		root string
+		want string
+	}{
+		{"already relative", "pkg/a.go", "/repo", "pkg/a.go"},
+		{"dot prefix", "./pkg/a.go", "/repo", "pkg/a.go"},
+		{"absolute under root", "/repo/pkg/a.go", "/repo", "pkg/a.go"},
+		{"file URI under root", "file:///repo/pkg/a.go", "/repo", "pkg/a.go"},
+		{"absolute outside root", "/other/a.go", "/repo", "/other/a.go"},
+		{"dotted name under root", "/repo/..foo/a.go", "/repo", "..foo/a.go"},
+		{"root's parent", "/repo/../a.go", "/repo", "/a.go"},
+		{"no root", "/repo/a.go", "", "/repo/a.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativePath(tt.path, tt.root); got != tt.want {
+				t.Errorf("RelativePath(%q, %q) = %q, want %q", tt.path, tt.root, got, tt.want)
+			}
+		})
+	}
+}
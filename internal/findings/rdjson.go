@@ -0,0 +1,107 @@
+package findings
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type rdSource struct {
+	Name string `json:"name"`
+}
+
+type rdDiagnostic struct {
+	Message  string `json:"message"`
+	Location struct {
+		Path  string `json:"path"`
+		Range struct {
+			Start struct {
+				Line int `json:"line"`
+			} `json:"start"`
+			End struct {
+				Line int `json:"line"`
+			} `json:"end"`
+		} `json:"range"`
+	} `json:"location"`
+	Severity string   `json:"severity"`
+	Source   rdSource `json:"source"`
+	Code     struct {
+		Value string `json:"value"`
+	} `json:"code"`
+}
+
+type rdDiagnosticResult struct {
+	Source      rdSource       `json:"source"`
+	Diagnostics []rdDiagnostic `json:"diagnostics"`
+}
+
+func (d rdDiagnostic) finding(defaultTool string) (Finding, bool) {
+	loc := d.Location
+	if loc.Path == "" || loc.Range.Start.Line <= 0 {
+		return Finding{}, false
+	}
+
+	tool := d.Source.Name
+	if tool == "" {
+		tool = defaultTool
+	}
+
+	start, line := lineRange(loc.Range.Start.Line, loc.Range.End.Line)
+	return Finding{
+		Path:      loc.Path,
+		StartLine: start,
+		Line:      line,
+		Message:   d.Message,
+		Rule:      d.Code.Value,
+		Severity:  strings.ToLower(d.Severity),
+		Tool:      tool,
+	}, true
+}
+
+// parseRDJSON reads a reviewdog DiagnosticResult document.
+func parseRDJSON(data []byte) ([]Finding, error) {
+	var result rdDiagnosticResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, d := range result.Diagnostics {
+		if f, ok := d.finding(result.Source.Name); ok {
+			findings = append(findings, f)
+		}
+	}
+
+	return findings, nil
+}
+
+// parseRDJSONL reads reviewdog diagnostics, one JSON object per line.
+func parseRDJSONL(data []byte) ([]Finding, error) {
+	var findings []Finding
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var d rdDiagnostic
+		if err := json.Unmarshal(line, &d); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if f, ok := d.finding(""); ok {
+			findings = append(findings, f)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return findings, nil
+}
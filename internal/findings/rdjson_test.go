@@ -0,0 +1,63 @@
+package findings
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRDJSON(t *testing.T) {
+	input := `{
+		"source": {"name": "staticcheck"},
+		"diagnostics": [
+			{
+				"message": "should use strings.Contains",
+				"location": {"path": "a.go", "range": {"start": {"line": 3}, "end": {"line": 6}}},
+				"severity": "WARNING",
+				"code": {"value": "S1003"}
+			},
+			{
+				"message": "from another tool",
+				"location": {"path": "b.go", "range": {"start": {"line": 7}}},
+				"source": {"name": "vet"}
+			}
+		]
+	}`
+
+	got, err := parseRDJSON([]byte(input))
+	if err != nil {
+		t.Fatalf("parseRDJSON() unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("parseRDJSON() returned %d findings, want 2", len(got))
+	}
+	if got[0].StartLine != 3 || got[0].Line != 6 || got[0].Rule != "S1003" || got[0].Tool != "staticcheck" {
+		t.Errorf("first finding = %+v", got[0])
+	}
+	if got[0].Severity != "warning" {
+		t.Errorf("severity = %q, want lowercased", got[0].Severity)
+	}
+	if got[1].Tool != "vet" || got[1].StartLine != 0 || got[1].Line != 7 {
+		t.Errorf("second finding = %+v", got[1])
+	}
+}
+
+func TestParseRDJSONL(t *testing.T) {
+	input := `{"message": "one", "location": {"path": "a.go", "range": {"start": {"line": 1}}}}
+
+{"message": "no range", "location": {"path": "a.go"}}
+{"message": "two", "location": {"path": "b.go", "range": {"start": {"line": 2}}}}
+`
+
+	got, err := parseRDJSONL([]byte(input))
+	if err != nil {
+		t.Fatalf("parseRDJSONL() unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("parseRDJSONL() returned %d findings, want 2", len(got))
+	}
+
+	_, err = parseRDJSONL([]byte("{\"message\": \"ok\"}\n{oops\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("parseRDJSONL() error = %v, want line 2", err)
+	}
+}
@@ -0,0 +1,79 @@
+package findings
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type sarifLog struct {
+	Version string `json:"version"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name string `json:"name"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Results []struct {
+			RuleID  string `json:"ruleId"`
+			Level   string `json:"level"`
+			Message struct {
+				Text     string `json:"text"`
+				Markdown string `json:"markdown"`
+			} `json:"message"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI string `json:"uri"`
+					} `json:"artifactLocation"`
+					Region struct {
+						StartLine int `json:"startLine"`
+						EndLine   int `json:"endLine"`
+					} `json:"region"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+// parseSARIF reads SARIF 2.1 logs. Results without a physical location with
+// a line number cannot be anchored and are skipped.
+func parseSARIF(data []byte) ([]Finding, error) {
+	var log sarifLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, err
+	}
+	if log.Version != "" && !strings.HasPrefix(log.Version, "2.") {
+		return nil, fmt.Errorf("unsupported SARIF version %q", log.Version)
+	}
+
+	var findings []Finding
+	for _, run := range log.Runs {
+		for _, res := range run.Results {
+			message := res.Message.Markdown
+			if message == "" {
+				message = res.Message.Text
+			}
+
+			for _, loc := range res.Locations {
+				phys := loc.PhysicalLocation
+				if phys.ArtifactLocation.URI == "" || phys.Region.StartLine <= 0 {
+					continue
+				}
+
+				start, line := lineRange(phys.Region.StartLine, phys.Region.EndLine)
+				findings = append(findings, Finding{
+					Path:      phys.ArtifactLocation.URI,
+					StartLine: start,
+					Line:      line,
+					Message:   message,
+					Rule:      res.RuleID,
+					Severity:  res.Level,
+					Tool:      run.Tool.Driver.Name,
+				})
+			}
+		}
+	}
+
+	return findings, nil
+}
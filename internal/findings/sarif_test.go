@@ -0,0 +1,66 @@
+package findings
+
+import "testing"
+
+func TestParseSARIF(t *testing.T) {
+	input := `{
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {"name": "golangci-lint"}},
+			"results": [
+				{
+					"ruleId": "errcheck",
+					"level": "error",
+					"message": {"text": "Error return value is not checked"},
+					"locations": [{"physicalLocation": {
+						"artifactLocation": {"uri": "pkg/a.go"},
+						"region": {"startLine": 10}
+					}}]
+				},
+				{
+					"ruleId": "gocyclo",
+					"level": "warning",
+					"message": {"text": "plain", "markdown": "**rich**"},
+					"locations": [{"physicalLocation": {
+						"artifactLocation": {"uri": "pkg/b.go"},
+						"region": {"startLine": 5, "endLine": 9}
+					}}]
+				},
+				{
+					"ruleId": "no-location",
+					"message": {"text": "skipped"}
+				}
+			]
+		}]
+	}`
+
+	got, err := parseSARIF([]byte(input))
+	if err != nil {
+		t.Fatalf("parseSARIF() unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("parseSARIF() returned %d findings, want 2", len(got))
+	}
+
+	first := got[0]
+	if first.Path != "pkg/a.go" || first.Line != 10 || first.StartLine != 0 {
+		t.Errorf("first finding = %+v", first)
+	}
+	if first.Tool != "golangci-lint" || first.Rule != "errcheck" || first.Severity != "error" {
+		t.Errorf("first finding metadata = %+v", first)
+	}
+
+	second := got[1]
+	if second.StartLine != 5 || second.Line != 9 {
+		t.Errorf("multi-line region = %d-%d, want 5-9", second.StartLine, second.Line)
+	}
+	if second.Message != "**rich**" {
+		t.Errorf("message = %q, want markdown preferred", second.Message)
+	}
+}
+
+func TestParseSARIFVersion(t *testing.T) {
+	if _, err := parseSARIF([]byte(`{"version": "1.0.0", "runs": []}`)); err == nil {
+		t.Error("parseSARIF() expected error for SARIF 1.x")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 )
 
 type jsonFormatter struct {
@@ -12,6 +13,7 @@ type jsonFormatter struct {
 
 func (f *jsonFormatter) Format(result Result) error {
 	var v interface{}
+	compact := false
 
 	switch r := result.(type) {
 	case CommentsResult:
@@ -20,6 +22,21 @@ func (f *jsonFormatter) Format(result Result) error {
 		v = f.formatView(r)
 	case AddResult:
 		v = f.formatAdd(r)
+	case BatchAddResult:
+		v = f.formatBatchAdd(r)
+	case ReviewsResult:
+		v = f.formatReviews(r)
+	case InboxResult:
+		v = f.formatInbox(r)
+	case WatchEvent:
+		// Events stream as NDJSON: one compact object per line.
+		v, compact = f.formatWatchEvent(r), true
+	case MarkReadResult:
+		v = f.formatMarkRead(r)
+	case DismissResult:
+		v = f.formatDismiss(r)
+	case RequestResult:
+		v = f.formatRequest(r)
 	case EditResult:
 		v = f.formatEdit(r)
 	case DeleteResult:
@@ -30,15 +47,31 @@ func (f *jsonFormatter) Format(result Result) error {
 		v = f.formatDiscard(r)
 	case ReplyResult:
 		v = f.formatReply(r)
+	case ReactResult:
+		v = f.formatReact(r)
+	case CommentResult:
+		v = f.formatComment(r)
 	case ResolveResult:
 		v = f.formatResolve(r)
+	case ThreadStateResult:
+		v = f.formatThreadState(r)
+	case ApplyResult:
+		v = f.formatApply(r)
+	case HideResult:
+		v = f.formatHide(r)
 	case NoOpResult:
 		v = f.formatNoOp(r)
 	default:
 		return fmt.Errorf("unknown result type: %T", result)
 	}
 
-	data, err := json.MarshalIndent(v, "", "  ")
+	var data []byte
+	var err error
+	if compact {
+		data, err = json.Marshal(v)
+	} else {
+		data, err = json.MarshalIndent(v, "", "  ")
+	}
 	if err != nil {
 		return fmt.Errorf("marshal JSON: %w", err)
 	}
@@ -65,11 +98,29 @@ type jsonCommentGroup struct {
 }
 
 type jsonComment struct {
-	ID    string `json:"id,omitempty"`
-	State string `json:"state"`
-	Path  string `json:"path,omitempty"`
-	Line  int    `json:"line,omitempty"`
-	Body  string `json:"body"`
+	ID                 string         `json:"id,omitempty"`
+	Ref                string         `json:"ref,omitempty"`
+	State              string         `json:"state"`
+	Path               string         `json:"path,omitempty"`
+	Line               int            `json:"line,omitempty"`
+	Body               string         `json:"body"`
+	ReplyTo            string         `json:"reply_to,omitempty"`
+	FileLevel          bool           `json:"file_level,omitempty"`
+	Minimized          bool           `json:"minimized,omitempty"`
+	Reason             string         `json:"minimized_reason,omitempty"`
+	Reactions          []jsonReaction `json:"reactions,omitempty"`
+	CreatedAt          *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time     `json:"updated_at,omitempty"`
+	PublishedAt        *time.Time     `json:"published_at,omitempty"`
+	ThreadLastActivity *time.Time     `json:"thread_last_activity,omitempty"`
+}
+
+// timeOrNil omits unknown times from JSON output.
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
 
 func (f *jsonFormatter) formatComments(r CommentsResult) jsonCommentsResult {
@@ -78,10 +129,21 @@ func (f *jsonFormatter) formatComments(r CommentsResult) jsonCommentsResult {
 		comments := make([]jsonComment, len(g.Comments))
 		for j, c := range g.Comments {
 			cmt := jsonComment{
-				State: c.State,
-				Path:  c.Path,
-				Line:  c.Line,
-				Body:  c.Body,
+				Ref:       c.Ref,
+				State:     c.State,
+				Path:      c.Path,
+				Line:      c.Line,
+				Body:      c.Body,
+				ReplyTo:   c.ReplyTo,
+				FileLevel: c.FileLevel,
+				Minimized: c.Minimized,
+				Reason:    c.MinimizedReason,
+				Reactions: jsonReactions(c.Reactions),
+
+				CreatedAt:          timeOrNil(c.CreatedAt),
+				UpdatedAt:          timeOrNil(c.UpdatedAt),
+				PublishedAt:        timeOrNil(c.PublishedAt),
+				ThreadLastActivity: timeOrNil(c.LastActivity),
 			}
 			if r.IncludeIDs {
 				cmt.ID = c.ID
@@ -106,17 +168,33 @@ type jsonViewResult struct {
 }
 
 type jsonViewThread struct {
-	ID       string            `json:"id,omitempty"`
-	Path     string            `json:"path"`
-	Line     int               `json:"line,omitempty"`
-	Resolved bool              `json:"resolved"`
-	Comments []jsonViewComment `json:"comments"`
+	ID                string            `json:"id,omitempty"`
+	Ref               string            `json:"ref,omitempty"`
+	Path              string            `json:"path"`
+	Line              int               `json:"line,omitempty"`
+	StartLine         int               `json:"start_line,omitempty"`
+	OriginalLine      int               `json:"original_line,omitempty"`
+	OriginalStartLine int               `json:"original_start_line,omitempty"`
+	Outdated          bool              `json:"outdated"`
+	FileLevel         bool              `json:"file_level,omitempty"`
+	Resolved          bool              `json:"resolved"`
+	DiffHunk          string            `json:"diff_hunk,omitempty"`
+	LastActivity      *time.Time        `json:"last_activity,omitempty"`
+	Comments          []jsonViewComment `json:"comments"`
 }
 
 type jsonViewComment struct {
-	ID     string `json:"id,omitempty"`
-	Author string `json:"author"`
-	Body   string `json:"body"`
+	ID        string         `json:"id,omitempty"`
+	Ref       string         `json:"ref,omitempty"`
+	Author    string         `json:"author"`
+	Body      string         `json:"body"`
+	Minimized bool           `json:"minimized,omitempty"`
+	Reason    string         `json:"minimized_reason,omitempty"`
+	Reactions []jsonReaction `json:"reactions,omitempty"`
+
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
 }
 
 func (f *jsonFormatter) formatView(r ViewResult) jsonViewResult {
@@ -125,8 +203,16 @@ func (f *jsonFormatter) formatView(r ViewResult) jsonViewResult {
 		comments := make([]jsonViewComment, len(t.Comments))
 		for j, c := range t.Comments {
 			cmt := jsonViewComment{
-				Author: c.Author,
-				Body:   c.Body,
+				Ref:       c.Ref,
+				Author:    c.Author,
+				Body:      c.Body,
+				Minimized: c.Minimized,
+				Reason:    c.MinimizedReason,
+				Reactions: jsonReactions(c.Reactions),
+
+				CreatedAt:   timeOrNil(c.CreatedAt),
+				UpdatedAt:   timeOrNil(c.UpdatedAt),
+				PublishedAt: timeOrNil(c.PublishedAt),
 			}
 			if r.IncludeIDs {
 				cmt.ID = c.ID
@@ -134,10 +220,18 @@ func (f *jsonFormatter) formatView(r ViewResult) jsonViewResult {
 			comments[j] = cmt
 		}
 		thread := jsonViewThread{
-			Path:     t.Path,
-			Line:     t.Line,
-			Resolved: t.Resolved,
-			Comments: comments,
+			Ref:               t.Ref,
+			Path:              t.Path,
+			Line:              t.Line,
+			StartLine:         t.StartLine,
+			OriginalLine:      t.OriginalLine,
+			OriginalStartLine: t.OriginalStartLine,
+			Outdated:          t.Outdated,
+			FileLevel:         t.FileLevel,
+			Resolved:          t.Resolved,
+			DiffHunk:          t.DiffHunk,
+			LastActivity:      timeOrNil(t.LastActivity),
+			Comments:          comments,
 		}
 		if r.IncludeIDs {
 			thread.ID = t.ID
@@ -152,19 +246,239 @@ func (f *jsonFormatter) formatView(r ViewResult) jsonViewResult {
 }
 
 type jsonAddResult struct {
-	Action string `json:"action"`
-	Path   string `json:"path"`
-	Line   int    `json:"line"`
+	Action    string `json:"action"`
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	FileLevel bool   `json:"file_level,omitempty"`
 }
 
 func (f *jsonFormatter) formatAdd(r AddResult) jsonAddResult {
 	return jsonAddResult{
-		Action: "added",
-		Path:   r.Path,
-		Line:   r.Line,
+		Action:    "added",
+		Path:      r.Path,
+		Line:      r.Line,
+		FileLevel: r.FileLevel,
+	}
+}
+
+type jsonBatchAddResult struct {
+	Action   string             `json:"action"`
+	ReviewID string             `json:"review_id,omitempty"`
+	Added    int                `json:"added"`
+	Skipped  int                `json:"skipped"`
+	Failed   int                `json:"failed"`
+	Results  []jsonBatchAddItem `json:"results"`
+}
+
+type jsonBatchAddItem struct {
+	Record    int    `json:"record"`
+	Status    string `json:"status"`
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	FileLevel bool   `json:"file_level,omitempty"`
+	ThreadID  string `json:"thread_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+func (f *jsonFormatter) formatBatchAdd(r BatchAddResult) jsonBatchAddResult {
+	results := make([]jsonBatchAddItem, len(r.Items))
+	for i, item := range r.Items {
+		status := "added"
+		switch {
+		case item.Error != "":
+			status = "failed"
+		case item.Skipped != "":
+			status = "skipped"
+		}
+		results[i] = jsonBatchAddItem{
+			Record:    item.Record,
+			Status:    status,
+			Path:      item.Path,
+			Line:      item.Line,
+			FileLevel: item.FileLevel,
+			ThreadID:  item.ThreadID,
+			Error:     item.Error,
+			Reason:    item.Skipped,
+		}
+	}
+
+	return jsonBatchAddResult{
+		Action:   "batch_added",
+		ReviewID: r.ReviewID,
+		Added:    r.Added(),
+		Skipped:  r.Skipped(),
+		Failed:   r.Failed(),
+		Results:  results,
 	}
 }
 
+type jsonReviewsResult struct {
+	PR                string       `json:"pr"`
+	LatestPerReviewer bool         `json:"latest_per_reviewer"`
+	Reviews           []jsonReview `json:"reviews"`
+}
+
+type jsonReview struct {
+	ID           string     `json:"id"`
+	Author       string     `json:"author"`
+	State        string     `json:"state"`
+	Body         string     `json:"body"`
+	SubmittedAt  *time.Time `json:"submitted_at"`
+	CommentCount int        `json:"comment_count"`
+	CommitOID    string     `json:"commit_oid,omitempty"`
+	URL          string     `json:"url,omitempty"`
+}
+
+func (f *jsonFormatter) formatReviews(r ReviewsResult) jsonReviewsResult {
+	reviews := make([]jsonReview, len(r.Reviews))
+	for i, review := range r.Reviews {
+		reviews[i] = jsonReview{
+			ID:           review.ID,
+			Author:       review.Author,
+			State:        review.State,
+			Body:         review.Body,
+			CommentCount: review.Comments,
+			CommitOID:    review.CommitOID,
+			URL:          review.URL,
+		}
+		if !review.SubmittedAt.IsZero() {
+			submitted := review.SubmittedAt
+			reviews[i].SubmittedAt = &submitted
+		}
+	}
+
+	return jsonReviewsResult{
+		PR:                r.PRRef,
+		LatestPerReviewer: r.LatestPerReviewer,
+		Reviews:           reviews,
+	}
+}
+
+type jsonInboxResult struct {
+	PRs []jsonInboxItem `json:"prs"`
+}
+
+type jsonInboxItem struct {
+	PR                string    `json:"pr"`
+	Title             string    `json:"title"`
+	URL               string    `json:"url"`
+	Author            string    `json:"author"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	ReviewRequested   bool      `json:"review_requested"`
+	PendingDrafts     int       `json:"pending_drafts"`
+	UnansweredThreads int       `json:"unanswered_threads"`
+}
+
+func (f *jsonFormatter) formatInbox(r InboxResult) jsonInboxResult {
+	prs := make([]jsonInboxItem, len(r.Items))
+	for i, item := range r.Items {
+		prs[i] = jsonInboxItem{
+			PR:                item.PRRef,
+			Title:             item.Title,
+			URL:               item.URL,
+			Author:            item.Author,
+			UpdatedAt:         item.UpdatedAt,
+			ReviewRequested:   item.ReviewRequested,
+			PendingDrafts:     item.Drafts,
+			UnansweredThreads: item.Unanswered,
+		}
+	}
+	return jsonInboxResult{PRs: prs}
+}
+
+type jsonWatchEvent struct {
+	Type    string    `json:"type"`
+	PR      string    `json:"pr"`
+	Time    time.Time `json:"time"`
+	Thread  string    `json:"thread,omitempty"`
+	Comment string    `json:"comment,omitempty"`
+	ID      string    `json:"id,omitempty"`
+	Author  string    `json:"author,omitempty"`
+	Path    string    `json:"path,omitempty"`
+	Line    int       `json:"line,omitempty"`
+	File    bool      `json:"file_level,omitempty"`
+	State   string    `json:"state,omitempty"`
+	Body    string    `json:"body,omitempty"`
+	URL     string    `json:"url,omitempty"`
+}
+
+func (f *jsonFormatter) formatWatchEvent(e WatchEvent) jsonWatchEvent {
+	return jsonWatchEvent{
+		Type:    e.Kind,
+		PR:      e.PRRef,
+		Time:    e.Time,
+		Thread:  e.ThreadRef,
+		Comment: e.CommentRef,
+		ID:      e.ID,
+		Author:  e.Author,
+		Path:    e.Path,
+		Line:    e.Line,
+		File:    e.FileLevel,
+		State:   e.State,
+		Body:    e.Body,
+		URL:     e.URL,
+	}
+}
+
+type jsonMarkReadResult struct {
+	Action    string     `json:"action"`
+	PR        string     `json:"pr"`
+	CommentID string     `json:"comment_id,omitempty"`
+	At        *time.Time `json:"at,omitempty"`
+}
+
+func (f *jsonFormatter) formatMarkRead(r MarkReadResult) jsonMarkReadResult {
+	if r.Cleared {
+		return jsonMarkReadResult{Action: "cleared", PR: r.PRRef}
+	}
+	return jsonMarkReadResult{
+		Action:    "marked_read",
+		PR:        r.PRRef,
+		CommentID: r.CommentID,
+		At:        timeOrNil(r.At),
+	}
+}
+
+type jsonDismissResult struct {
+	Action   string `json:"action"`
+	ReviewID string `json:"review_id"`
+	Author   string `json:"author,omitempty"`
+	State    string `json:"state,omitempty"`
+}
+
+func (f *jsonFormatter) formatDismiss(r DismissResult) jsonDismissResult {
+	return jsonDismissResult{
+		Action:   "dismissed",
+		ReviewID: r.ReviewID,
+		Author:   r.Author,
+		State:    r.State,
+	}
+}
+
+type jsonRequestResult struct {
+	Action    string   `json:"action"`
+	PR        string   `json:"pr"`
+	Requested []string `json:"requested"`
+	Pending   []string `json:"pending"`
+}
+
+func (f *jsonFormatter) formatRequest(r RequestResult) jsonRequestResult {
+	result := jsonRequestResult{
+		Action:    "requested",
+		PR:        r.PRRef,
+		Requested: r.Requested,
+		Pending:   r.Pending,
+	}
+	if result.Requested == nil {
+		result.Requested = []string{}
+	}
+	if result.Pending == nil {
+		result.Pending = []string{}
+	}
+	return result
+}
+
 type jsonEditResult struct {
 	Action    string `json:"action"`
 	CommentID string `json:"comment_id"`
@@ -221,14 +535,97 @@ type jsonReplyResult struct {
 }
 
 func (f *jsonFormatter) formatReply(r ReplyResult) jsonReplyResult {
+	action := "replied"
+	if r.Draft {
+		action = "drafted"
+	}
 	return jsonReplyResult{
-		Action:    "replied",
+		Action:    action,
 		ThreadID:  r.ThreadID,
 		CommentID: r.CommentID,
 		URL:       r.URL,
 	}
 }
 
+type jsonReaction struct {
+	Content       string `json:"content"`
+	Count         int    `json:"count"`
+	ViewerReacted bool   `json:"viewer_reacted"`
+}
+
+func jsonReactions(reactions []Reaction) []jsonReaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+	out := make([]jsonReaction, len(reactions))
+	for i, r := range reactions {
+		out[i] = jsonReaction{
+			Content:       r.Name,
+			Count:         r.Count,
+			ViewerReacted: r.Viewer,
+		}
+	}
+	return out
+}
+
+type jsonReactResult struct {
+	Action    string          `json:"action"`
+	Reaction  string          `json:"reaction"`
+	Succeeded int             `json:"succeeded"`
+	Failed    int             `json:"failed"`
+	Results   []jsonReactItem `json:"results"`
+}
+
+type jsonReactItem struct {
+	CommentID string `json:"comment_id"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (f *jsonFormatter) formatReact(r ReactResult) jsonReactResult {
+	action := "reacted"
+	if r.Removed {
+		action = "removed"
+	}
+
+	results := make([]jsonReactItem, len(r.Items))
+	for i, item := range r.Items {
+		status := action
+		if item.Error != "" {
+			status = "failed"
+		}
+		results[i] = jsonReactItem{
+			CommentID: item.CommentID,
+			Status:    status,
+			Error:     item.Error,
+		}
+	}
+
+	return jsonReactResult{
+		Action:    action,
+		Reaction:  r.Reaction,
+		Succeeded: len(r.Items) - r.Failed(),
+		Failed:    r.Failed(),
+		Results:   results,
+	}
+}
+
+type jsonCommentResult struct {
+	Action    string `json:"action"`
+	PR        string `json:"pr"`
+	CommentID string `json:"comment_id"`
+	URL       string `json:"url,omitempty"`
+}
+
+func (f *jsonFormatter) formatComment(r CommentResult) jsonCommentResult {
+	return jsonCommentResult{
+		Action:    "commented",
+		PR:        r.PRRef,
+		CommentID: r.CommentID,
+		URL:       r.URL,
+	}
+}
+
 type jsonResolveResult struct {
 	Action   string `json:"action"`
 	ThreadID string `json:"thread_id"`
@@ -236,13 +633,162 @@ type jsonResolveResult struct {
 }
 
 func (f *jsonFormatter) formatResolve(r ResolveResult) jsonResolveResult {
+	action := "resolved"
+	if !r.Resolved {
+		action = "unresolved"
+	}
 	return jsonResolveResult{
-		Action:   "resolved",
+		Action:   action,
 		ThreadID: r.ThreadID,
 		Resolved: r.Resolved,
 	}
 }
 
+type jsonThreadStateResult struct {
+	Action    string                `json:"action"`
+	Succeeded int                   `json:"succeeded"`
+	Failed    int                   `json:"failed"`
+	Results   []jsonThreadStateItem `json:"results"`
+}
+
+type jsonThreadStateItem struct {
+	ThreadID string `json:"thread_id"`
+	Status   string `json:"status"`
+	Path     string `json:"path,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (f *jsonFormatter) formatThreadState(r ThreadStateResult) jsonThreadStateResult {
+	action := "resolved"
+	if !r.Resolved {
+		action = "unresolved"
+	}
+
+	results := make([]jsonThreadStateItem, len(r.Items))
+	for i, item := range r.Items {
+		status := action
+		if item.Error != "" {
+			status = "failed"
+		}
+		results[i] = jsonThreadStateItem{
+			ThreadID: item.ThreadID,
+			Status:   status,
+			Path:     item.Path,
+			Line:     item.Line,
+			Error:    item.Error,
+		}
+	}
+
+	return jsonThreadStateResult{
+		Action:    action,
+		Succeeded: len(r.Items) - r.Failed(),
+		Failed:    r.Failed(),
+		Results:   results,
+	}
+}
+
+type jsonHideResult struct {
+	Action    string         `json:"action"`
+	Reason    string         `json:"reason,omitempty"`
+	Succeeded int            `json:"succeeded"`
+	Failed    int            `json:"failed"`
+	Results   []jsonHideItem `json:"results"`
+}
+
+type jsonHideItem struct {
+	CommentID string `json:"comment_id"`
+	Ref       string `json:"ref,omitempty"`
+	Status    string `json:"status"`
+	Author    string `json:"author,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (f *jsonFormatter) formatHide(r HideResult) jsonHideResult {
+	action, reason := "hidden", r.Reason
+	if !r.Hidden {
+		action, reason = "shown", ""
+	}
+
+	results := make([]jsonHideItem, len(r.Items))
+	for i, item := range r.Items {
+		status := action
+		if item.Error != "" {
+			status = "failed"
+		}
+		results[i] = jsonHideItem{
+			CommentID: item.CommentID,
+			Ref:       item.Ref,
+			Status:    status,
+			Author:    item.Author,
+			Path:      item.Path,
+			Line:      item.Line,
+			Error:     item.Error,
+		}
+	}
+
+	return jsonHideResult{
+		Action:    action,
+		Reason:    reason,
+		Succeeded: len(r.Items) - r.Failed(),
+		Failed:    r.Failed(),
+		Results:   results,
+	}
+}
+
+type jsonApplyResult struct {
+	Action  string          `json:"action"`
+	DryRun  bool            `json:"dry_run"`
+	Commit  string          `json:"commit,omitempty"`
+	Applied int             `json:"applied"`
+	Failed  int             `json:"failed"`
+	Results []jsonApplyItem `json:"results"`
+}
+
+type jsonApplyItem struct {
+	ThreadID  string `json:"thread_id"`
+	CommentID string `json:"comment_id"`
+	Status    string `json:"status"`
+	Path      string `json:"path"`
+	StartLine int    `json:"start_line,omitempty"`
+	Line      int    `json:"line"`
+	Replied   bool   `json:"replied"`
+	Resolved  bool   `json:"resolved"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (f *jsonFormatter) formatApply(r ApplyResult) jsonApplyResult {
+	results := make([]jsonApplyItem, len(r.Items))
+	for i, item := range r.Items {
+		status := "applied"
+		if !item.Applied {
+			status = "conflict"
+		}
+		results[i] = jsonApplyItem{
+			ThreadID:  item.ThreadID,
+			CommentID: item.CommentID,
+			Status:    status,
+			Path:      item.Path,
+			StartLine: item.StartLine,
+			Line:      item.Line,
+			Replied:   item.Replied,
+			Resolved:  item.Resolved,
+			Error:     item.Error,
+		}
+	}
+
+	return jsonApplyResult{
+		Action:  "applied",
+		DryRun:  r.DryRun,
+		Commit:  r.Commit,
+		Applied: r.Applied(),
+		Failed:  r.Failed(),
+		Results: results,
+	}
+}
+
 type jsonNoOpResult struct {
 	Action  string `json:"action"`
 	Message string `json:"message"`
@@ -3,7 +3,9 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestJSONFormatterCommentsResult(t *testing.T) {
@@ -168,6 +170,124 @@ func TestJSONFormatterAddResult(t *testing.T) {
 	}
 }
 
+func TestJSONFormatterBatchAddResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := BatchAddResult{
+		ReviewID: "PRR_1",
+		Items: []BatchAddItem{
+			{Record: 1, Path: "main.go", Line: 42, ThreadID: "PRRT_1"},
+			{Record: 2, Path: "util.go", Line: 7, Error: "line must be positive"},
+			{Record: 3, Path: "db.go", Line: 3, Skipped: "duplicate"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &parsed)
+
+	if parsed["action"] != "batch_added" {
+		t.Errorf("action = %v, want %v", parsed["action"], "batch_added")
+	}
+	if parsed["added"] != float64(1) || parsed["failed"] != float64(1) || parsed["skipped"] != float64(1) {
+		t.Errorf("added/failed/skipped = %v/%v/%v, want 1/1/1", parsed["added"], parsed["failed"], parsed["skipped"])
+	}
+
+	results := parsed["results"].([]interface{})
+	failed := results[1].(map[string]interface{})
+	if failed["status"] != "failed" {
+		t.Errorf("status = %v, want %v", failed["status"], "failed")
+	}
+	if failed["error"] != "line must be positive" {
+		t.Errorf("error = %v, want %v", failed["error"], "line must be positive")
+	}
+	skipped := results[2].(map[string]interface{})
+	if skipped["status"] != "skipped" || skipped["reason"] != "duplicate" {
+		t.Errorf("skipped record = %v", skipped)
+	}
+}
+
+func TestJSONFormatterThreadStateResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ThreadStateResult{
+		Resolved: false,
+		Items: []ThreadStateItem{
+			{ThreadID: "PRRT_1", Path: "main.go", Line: 42},
+			{ThreadID: "PRRT_2", Error: "not found"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &parsed)
+
+	if parsed["action"] != "unresolved" {
+		t.Errorf("action = %v, want %v", parsed["action"], "unresolved")
+	}
+	if parsed["succeeded"] != float64(1) || parsed["failed"] != float64(1) {
+		t.Errorf("succeeded/failed = %v/%v, want 1/1", parsed["succeeded"], parsed["failed"])
+	}
+
+	results := parsed["results"].([]interface{})
+	first := results[0].(map[string]interface{})
+	if first["status"] != "unresolved" || first["path"] != "main.go" {
+		t.Errorf("first result = %v", first)
+	}
+	second := results[1].(map[string]interface{})
+	if second["status"] != "failed" || second["error"] != "not found" {
+		t.Errorf("second result = %v", second)
+	}
+}
+
+func TestJSONFormatterApplyResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ApplyResult{
+		Commit: "abc1234",
+		Items: []ApplyItem{
+			{ThreadID: "PRRT_1", CommentID: "PRRC_1", Path: "main.go", StartLine: 8, Line: 10, Applied: true, Replied: true, Resolved: true},
+			{ThreadID: "PRRT_2", CommentID: "PRRC_2", Path: "util.go", Line: 3, Error: "lines 3-3 changed since the suggestion was made"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &parsed)
+
+	if parsed["action"] != "applied" || parsed["commit"] != "abc1234" {
+		t.Errorf("action/commit = %v/%v", parsed["action"], parsed["commit"])
+	}
+	if parsed["applied"] != float64(1) || parsed["failed"] != float64(1) {
+		t.Errorf("applied/failed = %v/%v, want 1/1", parsed["applied"], parsed["failed"])
+	}
+
+	results := parsed["results"].([]interface{})
+	first := results[0].(map[string]interface{})
+	if first["status"] != "applied" || first["start_line"] != float64(8) || first["resolved"] != true {
+		t.Errorf("first result = %v", first)
+	}
+	second := results[1].(map[string]interface{})
+	if second["status"] != "conflict" || second["error"] == nil {
+		t.Errorf("second result = %v", second)
+	}
+}
+
 func TestJSONFormatterEditResult(t *testing.T) {
 	var buf bytes.Buffer
 	formatter, _ := NewFormatter(FormatJSON, &buf)
@@ -355,3 +475,499 @@ func TestJSONOutputIsValidJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestJSONFormatterViewResultWithRefs(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ViewResult{
+		PRRef: "owner/repo#456",
+		Threads: []ViewThread{
+			{
+				Ref:      "t2",
+				Path:     "file.go",
+				Line:     20,
+				Comments: []ViewThreadComment{{Ref: "c2.1", Author: "user1", Body: "comment body"}},
+			},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Threads []struct {
+			Ref      string `json:"ref"`
+			Comments []struct {
+				Ref string `json:"ref"`
+			} `json:"comments"`
+		} `json:"threads"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	if len(parsed.Threads) != 1 || parsed.Threads[0].Ref != "t2" {
+		t.Fatalf("threads = %+v, want ref t2", parsed.Threads)
+	}
+	if c := parsed.Threads[0].Comments; len(c) != 1 || c[0].Ref != "c2.1" {
+		t.Errorf("comments = %+v, want ref c2.1", c)
+	}
+}
+
+func TestJSONFormatterViewResultTimes(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	created := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	result := ViewResult{Threads: []ViewThread{{Path: "file.go", Line: 20, Comments: []ViewThreadComment{
+		{Author: "user1", Body: "Fix", CreatedAt: created, UpdatedAt: created.Add(time.Hour), PublishedAt: created},
+		{Author: "user2", Body: "Draft", CreatedAt: created},
+	}}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Threads []struct {
+			Comments []map[string]any `json:"comments"`
+		} `json:"threads"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	comments := parsed.Threads[0].Comments
+	if comments[0]["created_at"] != "2026-03-01T09:30:00Z" || comments[0]["updated_at"] != "2026-03-01T10:30:00Z" || comments[0]["published_at"] != "2026-03-01T09:30:00Z" {
+		t.Errorf("comment = %v, want its created, updated and published times", comments[0])
+	}
+	if _, ok := comments[1]["published_at"]; ok {
+		t.Errorf("draft = %v, want published_at omitted", comments[1])
+	}
+}
+
+func TestJSONFormatterViewResultIncludesHunk(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	hunk := "@@ -1,3 +1,3 @@\n package main\n-var a = 1\n+var a = 2"
+	result := ViewResult{
+		PRRef: "owner/repo#456",
+		Threads: []ViewThread{
+			{Path: "main.go", StartLine: 2, Line: 3, Outdated: false, DiffHunk: hunk},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Threads []struct {
+			StartLine int    `json:"start_line"`
+			Line      int    `json:"line"`
+			Outdated  *bool  `json:"outdated"`
+			DiffHunk  string `json:"diff_hunk"`
+		} `json:"threads"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	thread := parsed.Threads[0]
+	if thread.StartLine != 2 || thread.Line != 3 {
+		t.Errorf("lines = %d-%d, want 2-3", thread.StartLine, thread.Line)
+	}
+	if thread.Outdated == nil || *thread.Outdated {
+		t.Errorf("outdated = %v, want false", thread.Outdated)
+	}
+	if thread.DiffHunk != hunk {
+		t.Errorf("diff_hunk = %q, want the full hunk", thread.DiffHunk)
+	}
+}
+
+func TestJSONFormatterDraftReply(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	if err := formatter.Format(ReplyResult{ThreadID: "PRRT_1", CommentID: "PRRC_2", Draft: true}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if parsed["action"] != "drafted" {
+		t.Errorf("action = %v, want drafted", parsed["action"])
+	}
+}
+
+func TestJSONFormatterFileLevelThread(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ViewResult{Threads: []ViewThread{{Path: "logo.png", FileLevel: true}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Threads []map[string]interface{} `json:"threads"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	thread := parsed.Threads[0]
+	if thread["file_level"] != true {
+		t.Errorf("file_level = %v, want true", thread["file_level"])
+	}
+	if _, ok := thread["line"]; ok {
+		t.Error("line should be omitted for file-level threads")
+	}
+}
+
+func TestJSONFormatterHideResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := HideResult{
+		Hidden: true,
+		Reason: "off-topic",
+		Items: []HideItem{
+			{CommentID: "PRRC_1", Ref: "c1.1", Author: "octocat", Path: "main.go", Line: 42},
+			{CommentID: "IC_1", Error: "forbidden"},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &parsed)
+
+	if parsed["action"] != "hidden" || parsed["reason"] != "off-topic" {
+		t.Errorf("action/reason = %v/%v, want hidden/off-topic", parsed["action"], parsed["reason"])
+	}
+	if parsed["succeeded"] != float64(1) || parsed["failed"] != float64(1) {
+		t.Errorf("succeeded/failed = %v/%v, want 1/1", parsed["succeeded"], parsed["failed"])
+	}
+
+	results := parsed["results"].([]interface{})
+	first := results[0].(map[string]interface{})
+	if first["status"] != "hidden" || first["ref"] != "c1.1" || first["author"] != "octocat" {
+		t.Errorf("first result = %v", first)
+	}
+	second := results[1].(map[string]interface{})
+	if second["status"] != "failed" || second["error"] != "forbidden" {
+		t.Errorf("second result = %v", second)
+	}
+}
+
+func TestJSONFormatterCommentsResultHidden(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := CommentsResult{
+		Groups: []CommentGroup{{Comments: []*Comment{
+			{ID: "IC_1", Author: "bot", Body: "Deploy preview", State: "discussion", Minimized: true, MinimizedReason: "outdated"},
+			{ID: "IC_2", Author: "octocat", Body: "LGTM", State: "discussion"},
+		}}},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, `"minimized": true`) || !strings.Contains(output, `"minimized_reason": "outdated"`) {
+		t.Errorf("output should mark the hidden comment, got:\n%s", output)
+	}
+	if strings.Count(output, `"minimized"`) != 1 {
+		t.Error("shown comments should omit minimized")
+	}
+}
+
+func TestJSONFormatterCommentResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := CommentResult{PRRef: "owner/repo#1", CommentID: "IC_1", URL: "https://example.com/c"}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &parsed)
+
+	if parsed["action"] != "commented" || parsed["pr"] != "owner/repo#1" || parsed["comment_id"] != "IC_1" {
+		t.Errorf("parsed = %v", parsed)
+	}
+}
+
+func TestJSONFormatterReactions(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ViewResult{Threads: []ViewThread{{
+		ID: "PRRT_1",
+		Comments: []ViewThreadComment{
+			{Author: "octocat", Body: "Fix", Reactions: []Reaction{{Name: "+1", Emoji: "👍", Count: 2, Viewer: true}}},
+			{Author: "hubot", Body: "Done"},
+		},
+	}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Threads []struct {
+			Comments []map[string]interface{} `json:"comments"`
+		} `json:"threads"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+
+	comments := parsed.Threads[0].Comments
+	reactions := comments[0]["reactions"].([]interface{})
+	first := reactions[0].(map[string]interface{})
+	if first["content"] != "+1" || first["count"] != float64(2) || first["viewer_reacted"] != true {
+		t.Errorf("reaction = %v", first)
+	}
+	if _, ok := comments[1]["reactions"]; ok {
+		t.Error("comments without reactions should omit reactions")
+	}
+}
+
+func TestJSONFormatterReactResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ReactResult{Reaction: "eyes", Emoji: "👀", Removed: true, Items: []ReactItem{{CommentID: "PRRC_1"}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &parsed)
+
+	if parsed["action"] != "removed" || parsed["reaction"] != "eyes" || parsed["succeeded"] != float64(1) {
+		t.Errorf("parsed = %v", parsed)
+	}
+}
+
+func TestJSONFormatterReviewsResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := ReviewsResult{
+		PRRef:             "owner/repo#1",
+		LatestPerReviewer: true,
+		Reviews: []ReviewItem{
+			{ID: "PRR_1", Author: "octocat", State: "approved", SubmittedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), Comments: 2, CommitOID: "abc"},
+			{ID: "PRR_2", Author: "me", State: "pending"},
+		},
+	}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		PR                string                   `json:"pr"`
+		LatestPerReviewer bool                     `json:"latest_per_reviewer"`
+		Reviews           []map[string]interface{} `json:"reviews"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.PR != "owner/repo#1" || !parsed.LatestPerReviewer || len(parsed.Reviews) != 2 {
+		t.Fatalf("parsed = %+v", parsed)
+	}
+	first := parsed.Reviews[0]
+	if first["submitted_at"] != "2026-03-01T09:30:00Z" || first["comment_count"] != float64(2) || first["commit_oid"] != "abc" {
+		t.Errorf("first review = %v", first)
+	}
+	if parsed.Reviews[1]["submitted_at"] != nil {
+		t.Errorf("pending review submitted_at = %v, want null", parsed.Reviews[1]["submitted_at"])
+	}
+}
+
+func TestJSONFormatterRequestResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	if err := formatter.Format(RequestResult{PRRef: "owner/repo#1", Requested: []string{"octocat"}}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Action    string   `json:"action"`
+		PR        string   `json:"pr"`
+		Requested []string `json:"requested"`
+		Pending   []string `json:"pending"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Action != "requested" || parsed.PR != "owner/repo#1" || len(parsed.Requested) != 1 {
+		t.Errorf("parsed = %+v", parsed)
+	}
+	if !strings.Contains(buf.String(), `"pending": []`) {
+		t.Errorf("pending should be an empty array, got:\n%s", buf.String())
+	}
+}
+
+func TestJSONFormatterInboxResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	result := InboxResult{Items: []InboxItem{
+		{PRRef: "o/r#9", Title: "Fix typo", URL: "https://github.com/o/r/pull/9", Author: "me", Unanswered: 3},
+	}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		PRs []map[string]interface{} `json:"prs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.PRs) != 1 {
+		t.Fatalf("parsed %d PRs, want 1", len(parsed.PRs))
+	}
+	pr := parsed.PRs[0]
+	if pr["pr"] != "o/r#9" || pr["review_requested"] != false || pr["pending_drafts"] != float64(0) || pr["unanswered_threads"] != float64(3) {
+		t.Errorf("pr = %v", pr)
+	}
+}
+
+func TestJSONFormatterInboxResultEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	if err := formatter.Format(InboxResult{}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"prs": []`) {
+		t.Errorf("prs should be an empty array, got:\n%s", buf.String())
+	}
+}
+
+func TestJSONFormatterLastActivity(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	var view bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &view)
+	err := formatter.Format(ViewResult{Threads: []ViewThread{
+		{Path: "main.go", Line: 20, LastActivity: at},
+		{Path: "db.go", Line: 5},
+	}})
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	var parsedView struct {
+		Threads []map[string]interface{} `json:"threads"`
+	}
+	if err := json.Unmarshal(view.Bytes(), &parsedView); err != nil {
+		t.Fatal(err)
+	}
+	if got := parsedView.Threads[0]["last_activity"]; got != "2026-03-01T09:30:00Z" {
+		t.Errorf("last_activity = %v", got)
+	}
+	if _, ok := parsedView.Threads[1]["last_activity"]; ok {
+		t.Error("unknown last_activity should be omitted")
+	}
+
+	var comments bytes.Buffer
+	formatter, _ = NewFormatter(FormatJSON, &comments)
+	err = formatter.Format(CommentsResult{Groups: []CommentGroup{{Comments: []*Comment{{Body: "Fix", LastActivity: at}}}}})
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if !strings.Contains(comments.String(), `"thread_last_activity": "2026-03-01T09:30:00Z"`) {
+		t.Errorf("comments output should include thread_last_activity, got:\n%s", comments.String())
+	}
+}
+
+func TestJSONFormatterCommentTimes(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+	err := formatter.Format(CommentsResult{Groups: []CommentGroup{{Comments: []*Comment{
+		{Body: "Fix", CreatedAt: at, UpdatedAt: at.Add(time.Hour), PublishedAt: at},
+		{Body: "Draft", State: "pending", CreatedAt: at},
+	}}}})
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed struct {
+		Groups []struct {
+			Comments []map[string]interface{} `json:"comments"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	published := parsed.Groups[0].Comments[0]
+	if published["created_at"] != "2026-03-01T09:30:00Z" || published["updated_at"] != "2026-03-01T10:30:00Z" || published["published_at"] != "2026-03-01T09:30:00Z" {
+		t.Errorf("comment times = %v, %v, %v", published["created_at"], published["updated_at"], published["published_at"])
+	}
+	if _, ok := parsed.Groups[0].Comments[1]["published_at"]; ok {
+		t.Error("drafts should have no published_at")
+	}
+}
+
+func TestJSONFormatterWatchEventNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	events := []WatchEvent{
+		{Kind: EventReply, PRRef: "o/r#1", Time: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), CommentRef: "c1.2", Author: "octocat", Body: "Done"},
+		{Kind: EventResolved, PRRef: "o/r#1", Time: time.Date(2026, 3, 1, 9, 31, 0, 0, time.UTC), ThreadRef: "t2"},
+	}
+	for _, e := range events {
+		if err := formatter.Format(e); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one line per event, got %d: %q", len(lines), buf.String())
+	}
+	var first map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if first["type"] != "reply" || first["pr"] != "o/r#1" || first["comment"] != "c1.2" || first["time"] != "2026-03-01T09:30:00Z" {
+		t.Errorf("first event = %v", first)
+	}
+	if !strings.Contains(lines[1], `"type":"resolved"`) || !strings.Contains(lines[1], `"thread":"t2"`) {
+		t.Errorf("second event = %s", lines[1])
+	}
+}
+
+func TestJSONFormatterMarkReadResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatJSON, &buf)
+
+	if err := formatter.Format(MarkReadResult{PRRef: "o/r#1", CommentID: "IC_1", At: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if parsed["action"] != "marked_read" || parsed["pr"] != "o/r#1" || parsed["comment_id"] != "IC_1" || parsed["at"] != "2026-03-01T09:30:00Z" {
+		t.Errorf("parsed = %v", parsed)
+	}
+}
@@ -3,6 +3,10 @@ package output
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
+
+	"github.com/srnnkls/gh-review/internal/diff"
 )
 
 type Format string
@@ -21,13 +25,88 @@ type Result interface {
 	Type() string
 }
 
+// Comment is one comment as listed by comments. ReplyTo names the comment a
+// review comment replies to, by reference when it has one and by node ID
+// otherwise; it is empty for comments that start a thread.
 type Comment struct {
-	ID     string
-	Path   string
-	Line   int
-	Body   string
-	State  string
-	Author string
+	ID      string
+	Ref     string
+	Path    string
+	Line    int
+	Body    string
+	State   string
+	Author  string
+	ReplyTo string
+	// FileLevel comments are about the whole file and have no line.
+	FileLevel bool
+	// Minimized comments are hidden on GitHub, for MinimizedReason.
+	Minimized       bool
+	MinimizedReason string
+	Reactions       []Reaction
+	// LastActivity is when the comment's review thread last got a comment;
+	// zero for discussion comments.
+	LastActivity time.Time
+	// CreatedAt, UpdatedAt and PublishedAt are zero when unknown;
+	// PublishedAt is also zero for drafts.
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt time.Time
+}
+
+// Reaction counts one kind of emoji reaction on a comment, such as "+1"
+// shown as 👍. Viewer marks the kinds the authenticated user reacted with.
+type Reaction struct {
+	Name   string
+	Emoji  string
+	Count  int
+	Viewer bool
+}
+
+// reactionSummary renders reaction counts compactly, starring those that
+// include the viewer's own reaction, e.g. "👍 2* 👀 1".
+func reactionSummary(reactions []Reaction) string {
+	parts := make([]string, len(reactions))
+	for i, r := range reactions {
+		parts[i] = fmt.Sprintf("%s %d", r.Emoji, r.Count)
+		if r.Viewer {
+			parts[i] += "*"
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// hasReactions reports whether any of comments received a reaction.
+func hasReactions(comments []*Comment) bool {
+	for _, c := range comments {
+		if len(c.Reactions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTimes reports whether any comment knows when it was created, which
+// adds a creation time column.
+func hasTimes(comments []*Comment) bool {
+	for _, c := range comments {
+		if !c.CreatedAt.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasViewTimes reports whether any comment of threads knows when it was
+// created, which adds a creation time column.
+func hasViewTimes(threads []ViewThread) bool {
+	for _, t := range threads {
+		for _, c := range t.Comments {
+			if !c.CreatedAt.IsZero() {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 type CommentGroup struct {
@@ -43,35 +122,287 @@ type CommentsResult struct {
 
 func (r CommentsResult) Type() string { return "comments" }
 
+// ViewThread is a review thread as shown by view. Outdated threads have a
+// position only in the commit they were made on: Line then falls back to
+// OriginalLine and StartLine is zero, so their range is read from
+// OriginalStartLine and OriginalLine. DiffHunk is the hunk GitHub stores with
+// the thread, ending at the commented line.
 type ViewThread struct {
-	ID       string
-	Path     string
-	Line     int
-	Resolved bool
-	Comments []ViewThreadComment
+	ID                string
+	Ref               string
+	Path              string
+	Line              int
+	StartLine         int
+	OriginalLine      int
+	OriginalStartLine int
+	Outdated          bool
+	FileLevel         bool
+	DiffHunk          string
+	Resolved          bool
+	// LastActivity is when the latest comment was made; zero when unknown.
+	LastActivity time.Time
+	Comments     []ViewThreadComment
+}
+
+// span returns the thread's first and last line, falling back to the
+// original lines for outdated threads. start is zero for single lines.
+func (t ViewThread) span() (start, end int) {
+	if t.Line > 0 && !t.Outdated {
+		return t.StartLine, t.Line
+	}
+	return t.OriginalStartLine, t.OriginalLine
+}
+
+// location renders the thread's path and lines, e.g. "main.go:18-20".
+func (t ViewThread) location() string {
+	start, end := t.span()
+	switch {
+	case t.FileLevel:
+		return fileLocation(t.Path)
+	case end == 0:
+		return t.Path
+	case start > 0 && start != end:
+		return fmt.Sprintf("%s:%d-%d", t.Path, start, end)
+	}
+	return fmt.Sprintf("%s:%d", t.Path, end)
+}
+
+// contextLines returns the hunk header and the last n lines of the thread's
+// diff hunk, widened to cover every commented line.
+func (t ViewThread) contextLines(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	if start, end := t.span(); start > 0 && end-start+1 > n {
+		n = end - start + 1
+	}
+	return diff.HunkTail(t.DiffHunk, n)
 }
 
+// ViewThreadComment is one comment of a ViewThread. The times are zero
+// when unknown; PublishedAt is also zero for drafts.
 type ViewThreadComment struct {
 	ID     string
+	Ref    string
 	Author string
 	Body   string
+
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt time.Time
+
+	Minimized       bool
+	MinimizedReason string
+	Reactions       []Reaction
 }
 
+// ViewResult lists review threads. Context is the number of diff hunk lines
+// shown above each thread's comments; zero hides the code.
 type ViewResult struct {
 	PRRef      string
 	Threads    []ViewThread
 	IncludeIDs bool
+	Context    int
 }
 
 func (r ViewResult) Type() string { return "view" }
 
 type AddResult struct {
-	Path string
-	Line int
+	Path      string
+	Line      int
+	FileLevel bool
 }
 
 func (r AddResult) Type() string { return "add" }
 
+// BatchAddItem is the outcome of one record from a batch add. A record with
+// neither Error nor Skipped set was added.
+type BatchAddItem struct {
+	Record    int
+	Path      string
+	Line      int
+	ThreadID  string
+	Error     string
+	Skipped   string
+	FileLevel bool
+}
+
+type BatchAddResult struct {
+	ReviewID string
+	Items    []BatchAddItem
+}
+
+func (r BatchAddResult) Type() string { return "batch_add" }
+
+// Failed returns the number of records that could not be added.
+func (r BatchAddResult) Failed() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Skipped returns the number of records that were deliberately not added.
+func (r BatchAddResult) Skipped() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Error == "" && item.Skipped != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Added returns the number of records that became review comments.
+func (r BatchAddResult) Added() int {
+	return len(r.Items) - r.Failed() - r.Skipped()
+}
+
+// ReviewItem is one review of a PR. SubmittedAt is zero for a pending
+// review.
+type ReviewItem struct {
+	ID          string
+	Author      string
+	State       string
+	Body        string
+	SubmittedAt time.Time
+	Comments    int
+	CommitOID   string
+	URL         string
+}
+
+// ReviewsResult lists a PR's reviews. LatestPerReviewer marks a list of each
+// reviewer's effective review rather than every review.
+type ReviewsResult struct {
+	PRRef             string
+	Reviews           []ReviewItem
+	LatestPerReviewer bool
+	IncludeIDs        bool
+}
+
+func (r ReviewsResult) Type() string { return "reviews" }
+
+// shortOID abbreviates a commit hash as git does by default.
+func shortOID(oid string) string {
+	if len(oid) > 7 {
+		return oid[:7]
+	}
+	return oid
+}
+
+// activityTime renders a last activity time, or "-" when it is unknown.
+func activityTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(layout)
+}
+
+// submittedTime renders a review's submission time, or "pending".
+func submittedTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return "pending"
+	}
+	return t.Format(layout)
+}
+
+// DismissResult reports a dismissed review.
+type DismissResult struct {
+	ReviewID string
+	Author   string
+	State    string
+}
+
+func (r DismissResult) Type() string { return "dismiss" }
+
+// RequestResult reports review requests. Requested are the reviewers asked
+// by this request, users by login and teams as "org/team"; Pending are all
+// reviewers whose review is requested afterwards.
+type RequestResult struct {
+	PRRef     string
+	Requested []string
+	Pending   []string
+}
+
+func (r RequestResult) Type() string { return "request" }
+
+// reviewerNames renders logins and teams as mentions, e.g. "@alice, @org/team".
+func reviewerNames(reviewers []string) string {
+	names := make([]string, len(reviewers))
+	for i, r := range reviewers {
+		names[i] = "@" + r
+	}
+	return strings.Join(names, ", ")
+}
+
+// InboxItem is a PR that awaits the viewer: their review is requested,
+// they have unsubmitted draft comments on it, or they authored it and
+// Unanswered unresolved threads end with someone else's comment.
+type InboxItem struct {
+	PRRef           string
+	Title           string
+	URL             string
+	Author          string
+	UpdatedAt       time.Time
+	ReviewRequested bool
+	Drafts          int
+	Unanswered      int
+}
+
+// InboxResult lists the PRs awaiting the viewer, most recently updated first.
+type InboxResult struct {
+	Items []InboxItem
+}
+
+func (r InboxResult) Type() string { return "inbox" }
+
+// Watch event kinds, as reported by watch.
+const (
+	EventComment    = "comment"
+	EventReply      = "reply"
+	EventDiscussion = "discussion"
+	EventResolved   = "resolved"
+	EventUnresolved = "unresolved"
+	EventReview     = "review"
+	EventDismissed  = "dismissed"
+)
+
+// WatchEvent is one piece of new activity on a PR. Comment events carry the
+// comment's references, author and body; thread events the thread's
+// location; review events the reviewer, state and summary. Time is when the
+// activity happened, or when it was noticed if GitHub does not say.
+type WatchEvent struct {
+	Kind       string
+	PRRef      string
+	Time       time.Time
+	ThreadRef  string
+	CommentRef string
+	ID         string
+	Author     string
+	Path       string
+	Line       int
+	FileLevel  bool
+	State      string
+	Body       string
+	URL        string
+}
+
+func (r WatchEvent) Type() string { return "watch_event" }
+
+// MarkReadResult reports a PR marked read up to CommentID, created At, or
+// its read state Cleared. CommentID is empty when the PR has no comments.
+type MarkReadResult struct {
+	PRRef     string
+	CommentID string
+	At        time.Time
+	Cleared   bool
+}
+
+func (r MarkReadResult) Type() string { return "mark_read" }
+
 type EditResult struct {
 	CommentID string
 }
@@ -96,14 +427,55 @@ type DiscardResult struct {
 
 func (r DiscardResult) Type() string { return "discard" }
 
+// ReplyResult reports a reply. Draft replies were added to the pending
+// review and are not visible to others until it is submitted.
 type ReplyResult struct {
 	ThreadID  string
 	CommentID string
 	URL       string
+	Draft     bool
 }
 
 func (r ReplyResult) Type() string { return "reply" }
 
+// ReactItem is the outcome of reacting to one comment.
+type ReactItem struct {
+	CommentID string
+	Error     string
+}
+
+// ReactResult reports a reaction added to, or with Removed, taken back from
+// comments.
+type ReactResult struct {
+	Reaction string
+	Emoji    string
+	Removed  bool
+	Items    []ReactItem
+}
+
+func (r ReactResult) Type() string { return "react" }
+
+// Failed returns the number of comments the reaction could not be changed
+// on.
+func (r ReactResult) Failed() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// CommentResult reports a discussion comment posted on a PR's conversation.
+type CommentResult struct {
+	PRRef     string
+	CommentID string
+	URL       string
+}
+
+func (r CommentResult) Type() string { return "comment" }
+
 type ResolveResult struct {
 	ThreadID string
 	Resolved bool
@@ -111,12 +483,164 @@ type ResolveResult struct {
 
 func (r ResolveResult) Type() string { return "resolve" }
 
+// ThreadStateItem is the outcome of changing one thread's resolution. Path
+// and Line are empty when the thread was named by ID rather than selected by
+// a filter.
+type ThreadStateItem struct {
+	ThreadID string
+	Path     string
+	Line     int
+	Error    string
+}
+
+// ThreadStateResult reports a bulk resolve or unresolve. Resolved is the
+// state every item was moved to.
+type ThreadStateResult struct {
+	Resolved bool
+	Items    []ThreadStateItem
+}
+
+func (r ThreadStateResult) Type() string { return "thread_state" }
+
+// Failed returns the number of threads whose state could not be changed.
+func (r ThreadStateResult) Failed() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// ApplyItem is the outcome of applying one thread's suggestion locally.
+// StartLine and Line are where the change landed. Error is set when the
+// suggestion could not be applied, or when a follow-up reply or resolve
+// failed after it was.
+type ApplyItem struct {
+	ThreadID  string
+	CommentID string
+	Path      string
+	StartLine int
+	Line      int
+	Applied   bool
+	Replied   bool
+	Resolved  bool
+	Error     string
+}
+
+// ApplyResult reports suggestions applied to the working tree. Commit is
+// the commit holding the changes, if one was made.
+type ApplyResult struct {
+	DryRun bool
+	Commit string
+	Items  []ApplyItem
+}
+
+func (r ApplyResult) Type() string { return "apply" }
+
+// Applied returns the number of suggestions written to the working tree.
+func (r ApplyResult) Applied() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Applied {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns the number of items with an error.
+func (r ApplyResult) Failed() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// HideItem is the outcome of hiding or unhiding one comment. Ref, Author
+// and Path are empty when the comment was named by ID rather than selected
+// by a filter.
+type HideItem struct {
+	CommentID string
+	Ref       string
+	Author    string
+	Path      string
+	Line      int
+	Error     string
+}
+
+// HideResult reports hidden or unhidden comments. Reason is why they were
+// hidden.
+type HideResult struct {
+	Hidden bool
+	Reason string
+	Items  []HideItem
+}
+
+func (r HideResult) Type() string { return "hide" }
+
+// Failed returns the number of comments whose visibility could not be
+// changed.
+func (r HideResult) Failed() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// hiddenLabel marks the body of a minimized comment, e.g. "[hidden: spam]".
+func hiddenLabel(minimized bool, reason string) string {
+	switch {
+	case !minimized:
+		return ""
+	case reason == "":
+		return "[hidden] "
+	}
+	return "[hidden: " + reason + "] "
+}
+
 type NoOpResult struct {
 	Message string
 }
 
 func (r NoOpResult) Type() string { return "noop" }
 
+// fileLocation labels a file-level comment, which has no line.
+func fileLocation(path string) string {
+	return path + " (file)"
+}
+
+// commentLocation renders path:line, or the file-level label.
+func commentLocation(path string, line int, fileLevel bool) string {
+	if fileLevel {
+		return fileLocation(path)
+	}
+	return threadLocation(path, line)
+}
+
+// lineOrFile fills a line column, with "file" for file-level comments.
+func lineOrFile(line int, fileLevel bool) string {
+	if fileLevel {
+		return "file"
+	}
+	return fmt.Sprintf("%d", line)
+}
+
+// threadLocation renders path:line, or just the path when the line is unknown.
+func threadLocation(path string, line int) string {
+	if path == "" || line <= 0 {
+		return path
+	}
+	return fmt.Sprintf("%s:%d", path, line)
+}
+
 func NewFormatter(format Format, w io.Writer) (Formatter, error) {
 	switch format {
 	case FormatTable:
@@ -129,3 +653,22 @@ func NewFormatter(format Format, w io.Writer) (Formatter, error) {
 		return nil, fmt.Errorf("unsupported format: %q", format)
 	}
 }
+
+// hasRefs reports whether any comment carries a short reference.
+func hasRefs(comments []*Comment) bool {
+	for _, c := range comments {
+		if c.Ref != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// refOrDash keeps reference columns aligned for comments without one, such
+// as PR discussion comments.
+func refOrDash(ref string) string {
+	if ref == "" {
+		return "-"
+	}
+	return ref
+}
@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 )
 
@@ -83,6 +84,9 @@ func TestResultTypes(t *testing.T) {
 		{CommentsResult{}, "comments"},
 		{ViewResult{}, "view"},
 		{AddResult{}, "add"},
+		{BatchAddResult{}, "batch_add"},
+		{ThreadStateResult{}, "thread_state"},
+		{ApplyResult{}, "apply"},
 		{EditResult{}, "edit"},
 		{DeleteResult{}, "delete"},
 		{SubmitResult{}, "submit"},
@@ -176,3 +180,47 @@ func TestCommentsResultStruct(t *testing.T) {
 		t.Errorf("Groups length = %d, want %d", len(result.Groups), 1)
 	}
 }
+
+func TestViewThreadLocation(t *testing.T) {
+	tests := []struct {
+		name   string
+		thread ViewThread
+		want   string
+	}{
+		{"single line", ViewThread{Path: "a.go", Line: 20}, "a.go:20"},
+		{"range", ViewThread{Path: "a.go", StartLine: 18, Line: 20}, "a.go:18-20"},
+		{"outdated uses original lines", ViewThread{Path: "a.go", OriginalStartLine: 5, OriginalLine: 7, Outdated: true}, "a.go:5-7"},
+		{"outdated with fallback line", ViewThread{Path: "a.go", Line: 7, OriginalStartLine: 5, OriginalLine: 7, Outdated: true}, "a.go:5-7"},
+		{"no line", ViewThread{Path: "a.go"}, "a.go"},
+		{"file-level", ViewThread{Path: "logo.png", FileLevel: true}, "logo.png (file)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.thread.location(); got != tt.want {
+				t.Errorf("location() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViewThreadContextLines(t *testing.T) {
+	thread := ViewThread{
+		Path:     "a.go",
+		Line:     5,
+		DiffHunk: "@@ -1,4 +1,5 @@\n a\n b\n-c\n+C\n+D\n e",
+	}
+
+	if got := thread.contextLines(0); got != nil {
+		t.Errorf("contextLines(0) = %q, want nil", got)
+	}
+	if got := thread.contextLines(2); strings.Join(got, "|") != "@@ -1,4 +1,5 @@|+D| e" {
+		t.Errorf("contextLines(2) = %q", got)
+	}
+
+	// A multi-line thread shows all of its lines even with less context.
+	thread.StartLine = 3
+	if got := thread.contextLines(1); len(got) != 4 {
+		t.Errorf("contextLines(1) for lines 3-5 = %q, want header and 3 lines", got)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
+
+	"github.com/srnnkls/gh-review/internal/diff"
 )
 
 type plainFormatter struct {
@@ -18,6 +21,20 @@ func (f *plainFormatter) Format(result Result) error {
 		return f.formatView(r)
 	case AddResult:
 		return f.formatAdd(r)
+	case BatchAddResult:
+		return f.formatBatchAdd(r)
+	case ReviewsResult:
+		return f.formatReviews(r)
+	case InboxResult:
+		return f.formatInbox(r)
+	case WatchEvent:
+		return f.formatWatchEvent(r)
+	case MarkReadResult:
+		return f.formatMarkRead(r)
+	case DismissResult:
+		return f.formatDismiss(r)
+	case RequestResult:
+		return f.formatRequest(r)
 	case EditResult:
 		return f.formatEdit(r)
 	case DeleteResult:
@@ -28,8 +45,18 @@ func (f *plainFormatter) Format(result Result) error {
 		return f.formatDiscard(r)
 	case ReplyResult:
 		return f.formatReply(r)
+	case ReactResult:
+		return f.formatReact(r)
+	case CommentResult:
+		return f.formatComment(r)
 	case ResolveResult:
 		return f.formatResolve(r)
+	case ThreadStateResult:
+		return f.formatThreadState(r)
+	case ApplyResult:
+		return f.formatApply(r)
+	case HideResult:
+		return f.formatHide(r)
 	case NoOpResult:
 		return f.formatNoOp(r)
 	default:
@@ -38,23 +65,36 @@ func (f *plainFormatter) Format(result Result) error {
 }
 
 func (f *plainFormatter) formatComments(r CommentsResult) error {
+	withRefs, withReactions, withTimes := false, false, false
+	for _, group := range r.Groups {
+		withRefs = withRefs || hasRefs(group.Comments)
+		withReactions = withReactions || hasReactions(group.Comments)
+		withTimes = withTimes || hasTimes(group.Comments)
+	}
+
 	for _, group := range r.Groups {
 		if group.Author != "" {
 			fmt.Fprintf(f.w, "@%s\n", group.Author)
 		}
 		for _, c := range group.Comments {
-			parts := []string{c.State}
+			var parts []string
+			if withRefs {
+				parts = append(parts, refOrDash(c.Ref))
+			}
+			parts = append(parts, c.State)
 			if r.IncludeIDs {
 				parts = append(parts, c.ID)
 			}
 			if c.Path != "" {
-				loc := c.Path
-				if c.Line > 0 {
-					loc = fmt.Sprintf("%s:%d", c.Path, c.Line)
-				}
-				parts = append(parts, loc)
+				parts = append(parts, commentLocation(c.Path, c.Line, c.FileLevel))
+			}
+			if withTimes {
+				parts = append(parts, activityTime(c.CreatedAt, time.RFC3339))
+			}
+			parts = append(parts, hiddenLabel(c.Minimized, c.MinimizedReason)+c.Body)
+			if withReactions {
+				parts = append(parts, refOrDash(reactionSummary(c.Reactions)))
 			}
-			parts = append(parts, c.Body)
 			if group.Author == "" && c.Author != "" {
 				parts = append(parts, c.Author)
 			}
@@ -73,34 +113,138 @@ func joinTSV(parts []string) string {
 }
 
 func (f *plainFormatter) formatView(r ViewResult) error {
+	withTimes := hasViewTimes(r.Threads)
 	for _, t := range r.Threads {
 		status := "resolved"
 		if !t.Resolved {
 			status = "unresolved"
 		}
-		loc := t.Path
-		if t.Line > 0 {
-			loc = fmt.Sprintf("%s:%d", t.Path, t.Line)
-		}
+		parts := []string{status, t.location(), activityTime(t.LastActivity, time.RFC3339)}
 		if r.IncludeIDs {
-			fmt.Fprintf(f.w, "%s\t%s\t%s\n", t.ID, status, loc)
-		} else {
-			fmt.Fprintf(f.w, "%s\t%s\n", status, loc)
+			parts = append([]string{t.ID}, parts...)
+		}
+		if t.Ref != "" {
+			parts = append([]string{t.Ref}, parts...)
 		}
+		fmt.Fprintln(f.w, joinTSV(parts))
+
 		for _, c := range t.Comments {
-			body := strings.ReplaceAll(c.Body, "\n", " ")
+			parts := []string{c.Author}
+			if withTimes {
+				parts = append(parts, activityTime(c.CreatedAt, time.RFC3339))
+			}
+			parts = append(parts, hiddenLabel(c.Minimized, c.MinimizedReason)+strings.ReplaceAll(c.Body, "\n", " "))
+			if len(c.Reactions) > 0 {
+				parts = append(parts, reactionSummary(c.Reactions))
+			}
 			if r.IncludeIDs {
-				fmt.Fprintf(f.w, "\t%s\t%s\t%s\n", c.ID, c.Author, body)
-			} else {
-				fmt.Fprintf(f.w, "\t%s\t%s\n", c.Author, body)
+				parts = append([]string{c.ID}, parts...)
+			}
+			if c.Ref != "" {
+				parts = append([]string{c.Ref}, parts...)
 			}
+			fmt.Fprintln(f.w, "\t"+joinTSV(parts))
 		}
 	}
 	return nil
 }
 
 func (f *plainFormatter) formatAdd(r AddResult) error {
-	fmt.Fprintf(f.w, "added\t%s\t%d\n", r.Path, r.Line)
+	fmt.Fprintf(f.w, "added\t%s\t%s\n", r.Path, lineOrFile(r.Line, r.FileLevel))
+	return nil
+}
+
+func (f *plainFormatter) formatBatchAdd(r BatchAddResult) error {
+	for _, item := range r.Items {
+		if item.Error != "" {
+			fmt.Fprintf(f.w, "failed\t%s\t%s\t%s\n", item.Path, lineOrFile(item.Line, item.FileLevel), item.Error)
+			continue
+		}
+		if item.Skipped != "" {
+			fmt.Fprintf(f.w, "skipped\t%s\t%s\t%s\n", item.Path, lineOrFile(item.Line, item.FileLevel), item.Skipped)
+			continue
+		}
+		fmt.Fprintf(f.w, "added\t%s\t%s\n", item.Path, lineOrFile(item.Line, item.FileLevel))
+	}
+	return nil
+}
+
+func (f *plainFormatter) formatReviews(r ReviewsResult) error {
+	for _, review := range r.Reviews {
+		parts := []string{
+			review.ID,
+			review.State,
+			review.Author,
+			submittedTime(review.SubmittedAt, time.RFC3339),
+			fmt.Sprintf("%d", review.Comments),
+			refOrDash(shortOID(review.CommitOID)),
+			strings.ReplaceAll(review.Body, "\n", " "),
+		}
+		fmt.Fprintln(f.w, joinTSV(parts))
+	}
+	return nil
+}
+
+func (f *plainFormatter) formatInbox(r InboxResult) error {
+	for _, item := range r.Items {
+		requested := "no"
+		if item.ReviewRequested {
+			requested = "yes"
+		}
+		parts := []string{
+			item.PRRef,
+			requested,
+			fmt.Sprintf("%d", item.Drafts),
+			fmt.Sprintf("%d", item.Unanswered),
+			item.Author,
+			item.UpdatedAt.Format(time.RFC3339),
+			item.Title,
+		}
+		fmt.Fprintln(f.w, joinTSV(parts))
+	}
+	return nil
+}
+
+func (f *plainFormatter) formatWatchEvent(e WatchEvent) error {
+	where := ""
+	if e.Path != "" {
+		where = commentLocation(e.Path, e.Line, e.FileLevel)
+	}
+	ref := e.CommentRef
+	if ref == "" {
+		ref = e.ThreadRef
+	}
+	parts := []string{
+		e.Kind,
+		e.Time.Format(time.RFC3339),
+		refOrDash(ref),
+		refOrDash(e.ID),
+		refOrDash(e.Author),
+		refOrDash(where),
+		strings.ReplaceAll(e.Body, "\n", " "),
+	}
+	fmt.Fprintln(f.w, joinTSV(parts))
+	return nil
+}
+
+func (f *plainFormatter) formatMarkRead(r MarkReadResult) error {
+	if r.Cleared {
+		fmt.Fprintf(f.w, "cleared\t%s\n", r.PRRef)
+		return nil
+	}
+	fmt.Fprintln(f.w, joinTSV([]string{"marked", r.PRRef, refOrDash(r.CommentID), activityTime(r.At, time.RFC3339)}))
+	return nil
+}
+
+func (f *plainFormatter) formatDismiss(r DismissResult) error {
+	fmt.Fprintf(f.w, "dismissed\t%s\t%s\n", r.ReviewID, r.Author)
+	return nil
+}
+
+func (f *plainFormatter) formatRequest(r RequestResult) error {
+	for _, reviewer := range r.Requested {
+		fmt.Fprintf(f.w, "requested\t%s\n", reviewer)
+	}
 	return nil
 }
 
@@ -125,7 +269,31 @@ func (f *plainFormatter) formatDiscard(r DiscardResult) error {
 }
 
 func (f *plainFormatter) formatReply(r ReplyResult) error {
-	fmt.Fprintf(f.w, "replied\t%s\t%s\n", r.ThreadID, r.URL)
+	action := "replied"
+	if r.Draft {
+		action = "drafted"
+	}
+	fmt.Fprintf(f.w, "%s\t%s\t%s\n", action, r.ThreadID, r.URL)
+	return nil
+}
+
+func (f *plainFormatter) formatReact(r ReactResult) error {
+	status := "reacted"
+	if r.Removed {
+		status = "removed"
+	}
+	for _, item := range r.Items {
+		if item.Error != "" {
+			fmt.Fprintf(f.w, "failed\t%s\t%s\n", item.CommentID, item.Error)
+			continue
+		}
+		fmt.Fprintf(f.w, "%s\t%s\t%s\n", status, item.CommentID, r.Reaction)
+	}
+	return nil
+}
+
+func (f *plainFormatter) formatComment(r CommentResult) error {
+	fmt.Fprintf(f.w, "commented\t%s\t%s\n", r.CommentID, r.URL)
 	return nil
 }
 
@@ -138,6 +306,69 @@ func (f *plainFormatter) formatResolve(r ResolveResult) error {
 	return nil
 }
 
+func (f *plainFormatter) formatThreadState(r ThreadStateResult) error {
+	status := "resolved"
+	if !r.Resolved {
+		status = "unresolved"
+	}
+	for _, item := range r.Items {
+		parts := []string{status, item.ThreadID}
+		if item.Error != "" {
+			parts[0] = "failed"
+		}
+		if loc := threadLocation(item.Path, item.Line); loc != "" {
+			parts = append(parts, loc)
+		}
+		if item.Error != "" {
+			parts = append(parts, item.Error)
+		}
+		fmt.Fprintln(f.w, strings.Join(parts, "\t"))
+	}
+	return nil
+}
+
+func (f *plainFormatter) formatHide(r HideResult) error {
+	status := "hidden"
+	if !r.Hidden {
+		status = "shown"
+	}
+	for _, item := range r.Items {
+		parts := []string{status, item.CommentID}
+		if item.Error != "" {
+			parts[0] = "failed"
+		}
+		if loc := threadLocation(item.Path, item.Line); loc != "" {
+			parts = append(parts, loc)
+		}
+		if item.Error != "" {
+			parts = append(parts, item.Error)
+		}
+		fmt.Fprintln(f.w, strings.Join(parts, "\t"))
+	}
+	return nil
+}
+
+func (f *plainFormatter) formatApply(r ApplyResult) error {
+	for _, item := range r.Items {
+		status := "applied"
+		switch {
+		case !item.Applied:
+			status = "conflict"
+		case r.DryRun:
+			status = "applicable"
+		}
+		parts := []string{status, item.ThreadID, fmt.Sprintf("%s:%s", item.Path, diff.LineRange(item.StartLine, item.Line))}
+		if item.Error != "" {
+			parts = append(parts, item.Error)
+		}
+		fmt.Fprintln(f.w, strings.Join(parts, "\t"))
+	}
+	if r.Commit != "" {
+		fmt.Fprintf(f.w, "commit\t%s\n", r.Commit)
+	}
+	return nil
+}
+
 func (f *plainFormatter) formatNoOp(r NoOpResult) error {
 	fmt.Fprintf(f.w, "noop\t%s\n", r.Message)
 	return nil
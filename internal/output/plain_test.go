@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestPlainFormatterCommentsResult(t *testing.T) {
@@ -225,6 +226,105 @@ func TestPlainFormatterAddResult(t *testing.T) {
 	}
 }
 
+func TestPlainFormatterBatchAddResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := BatchAddResult{
+		ReviewID: "PRR_1",
+		Items: []BatchAddItem{
+			{Record: 1, Path: "main.go", Line: 42, ThreadID: "PRRT_1"},
+			{Record: 2, Path: "util.go", Line: 7, Error: "line must be positive"},
+			{Record: 3, Path: "db.go", Line: 3, Skipped: "duplicate"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	if lines[0] != "added\tmain.go\t42" {
+		t.Errorf("line 1 = %q", lines[0])
+	}
+	if lines[1] != "failed\tutil.go\t7\tline must be positive" {
+		t.Errorf("line 2 = %q", lines[1])
+	}
+	if lines[2] != "skipped\tdb.go\t3\tduplicate" {
+		t.Errorf("line 3 = %q", lines[2])
+	}
+}
+
+func TestPlainFormatterThreadStateResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := ThreadStateResult{
+		Resolved: false,
+		Items: []ThreadStateItem{
+			{ThreadID: "PRRT_1", Path: "main.go", Line: 42},
+			{ThreadID: "PRRT_2"},
+			{ThreadID: "PRRT_3", Error: "not found"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	if lines[0] != "unresolved\tPRRT_1\tmain.go:42" {
+		t.Errorf("line 1 = %q", lines[0])
+	}
+	if lines[1] != "unresolved\tPRRT_2" {
+		t.Errorf("line 2 = %q", lines[1])
+	}
+	if lines[2] != "failed\tPRRT_3\tnot found" {
+		t.Errorf("line 3 = %q", lines[2])
+	}
+}
+
+func TestPlainFormatterApplyResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := ApplyResult{
+		Commit: "abc1234",
+		Items: []ApplyItem{
+			{ThreadID: "PRRT_1", Path: "main.go", StartLine: 8, Line: 10, Applied: true},
+			{ThreadID: "PRRT_2", Path: "util.go", Line: 3, Error: "changed"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		"applied\tPRRT_1\tmain.go:8-10",
+		"conflict\tPRRT_2\tutil.go:3\tchanged",
+		"commit\tabc1234",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i+1, lines[i], want[i])
+		}
+	}
+}
+
 func TestPlainFormatterEditResult(t *testing.T) {
 	var buf bytes.Buffer
 	formatter, _ := NewFormatter(FormatPlain, &buf)
@@ -447,3 +547,288 @@ func TestPlainFormatterGlobalComment(t *testing.T) {
 		t.Error("output should contain state even for global comments")
 	}
 }
+
+func TestPlainFormatterCommentsResultWithRefs(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := CommentsResult{
+		PRRef: "owner/repo#1",
+		Groups: []CommentGroup{
+			{
+				Author: "alice",
+				Comments: []*Comment{
+					{Ref: "c1.2", Path: "main.go", Line: 3, Body: "Inline"},
+					{Body: "General"},
+				},
+			},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasPrefix(lines[1], "\tc1.2\t") {
+		t.Errorf("line 1 = %q, want the comment ref first", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "\t-\t") {
+		t.Errorf("line 2 = %q, want a placeholder for comments without a ref", lines[2])
+	}
+}
+
+func TestPlainFormatterFileLevelAdd(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	if err := formatter.Format(AddResult{Path: "logo.png", FileLevel: true}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if got, want := buf.String(), "added\tlogo.png\tfile\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterHideResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := HideResult{
+		Hidden: false,
+		Items: []HideItem{
+			{CommentID: "PRRC_1", Path: "main.go", Line: 42},
+			{CommentID: "IC_1"},
+			{CommentID: "IC_2", Error: "forbidden"},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		"shown\tPRRC_1\tmain.go:42",
+		"shown\tIC_1",
+		"failed\tIC_2\tforbidden",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d", len(want), len(lines))
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i+1, lines[i], want[i])
+		}
+	}
+}
+
+func TestPlainFormatterCommentResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := CommentResult{PRRef: "owner/repo#1", CommentID: "IC_1", URL: "https://example.com/c"}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	if got, want := buf.String(), "commented\tIC_1\thttps://example.com/c\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterCommentsWithReactions(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := CommentsResult{Groups: []CommentGroup{{Comments: []*Comment{
+		{State: "commented", Body: "Fix", Author: "octocat", Reactions: []Reaction{{Name: "+1", Emoji: "👍", Count: 1}}},
+		{State: "commented", Body: "Nit", Author: "hubot"},
+	}}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		"commented\tFix\t👍 1\toctocat",
+		"commented\tNit\t-\thubot",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i+1, lines[i], want[i])
+		}
+	}
+}
+
+func TestPlainFormatterReactResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := ReactResult{Reaction: "+1", Emoji: "👍", Items: []ReactItem{{CommentID: "PRRC_1"}, {CommentID: "IC_1", Error: "forbidden"}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	if got, want := buf.String(), "reacted\tPRRC_1\t+1\nfailed\tIC_1\tforbidden\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterReviewsResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := ReviewsResult{Reviews: []ReviewItem{
+		{ID: "PRR_1", Author: "octocat", State: "changes_requested", Body: "Needs\ntests", SubmittedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), Comments: 2, CommitOID: "abcdef1234567"},
+		{ID: "PRR_2", Author: "me", State: "pending"},
+	}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	want := []string{
+		"PRR_1\tchanges_requested\toctocat\t2026-03-01T09:30:00Z\t2\tabcdef1\tNeeds tests",
+		"PRR_2\tpending\tme\tpending\t0\t-\t",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i+1, lines[i], want[i])
+		}
+	}
+}
+
+func TestPlainFormatterRequestResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	if err := formatter.Format(RequestResult{Requested: []string{"octocat", "acme/backend"}, Pending: []string{"hubot"}}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if got, want := buf.String(), "requested\toctocat\nrequested\tacme/backend\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterInboxResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := InboxResult{Items: []InboxItem{
+		{PRRef: "o/r#7", Title: "Add cache", Author: "octocat", UpdatedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), ReviewRequested: true, Drafts: 2},
+	}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if got, want := buf.String(), "o/r#7\tyes\t2\t0\toctocat\t2026-03-01T09:30:00Z\tAdd cache\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterViewResultLastActivity(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := ViewResult{Threads: []ViewThread{
+		{Ref: "t1", Path: "main.go", Line: 20, LastActivity: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{Ref: "t2", Path: "db.go", Line: 5},
+	}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	want := "t1\tunresolved\tmain.go:20\t2026-03-01T09:30:00Z\nt2\tunresolved\tdb.go:5\t-\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterViewResultCreatedAt(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := ViewResult{Threads: []ViewThread{{Ref: "t1", Path: "main.go", Line: 20, Comments: []ViewThreadComment{
+		{Ref: "c1.1", Author: "a", Body: "Fix", CreatedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{Ref: "c1.2", Author: "b", Body: "Done"},
+	}}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	want := "t1\tunresolved\tmain.go:20\t-\n" +
+		"\tc1.1\ta\t2026-03-01T09:30:00Z\tFix\n" +
+		"\tc1.2\tb\t-\tDone\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterCommentsCreatedAt(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	result := CommentsResult{Groups: []CommentGroup{{Comments: []*Comment{
+		{State: "commented", Path: "main.go", Line: 20, Body: "Fix", Author: "a", CreatedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{State: "discussion", Body: "LGTM", Author: "b"},
+	}}}}
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	want := "commented\tmain.go:20\t2026-03-01T09:30:00Z\tFix\ta\ndiscussion\t-\tLGTM\tb\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestPlainFormatterWatchEvent(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	events := []WatchEvent{
+		{Kind: EventComment, Time: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), ThreadRef: "t3", CommentRef: "c3.1", ID: "PRRC_5", Author: "hubot", Path: "api.go", FileLevel: true, Body: "Split\nthis"},
+		{Kind: EventDiscussion, Time: time.Date(2026, 3, 1, 9, 31, 0, 0, time.UTC), ID: "IC_2", Author: "hubot", Body: "LGTM"},
+	}
+	for _, e := range events {
+		if err := formatter.Format(e); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+	}
+
+	want := "comment\t2026-03-01T09:30:00Z\tc3.1\tPRRC_5\thubot\tapi.go (file)\tSplit this\n" +
+		"discussion\t2026-03-01T09:31:00Z\t-\tIC_2\thubot\t-\tLGTM\n"
+	if buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestPlainFormatterMarkReadResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter, _ := NewFormatter(FormatPlain, &buf)
+
+	results := []MarkReadResult{
+		{PRRef: "o/r#1", CommentID: "IC_1", At: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{PRRef: "o/r#2"},
+		{PRRef: "o/r#3", Cleared: true},
+	}
+	for _, r := range results {
+		if err := formatter.Format(r); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+	}
+
+	want := "marked\to/r#1\tIC_1\t2026-03-01T09:30:00Z\nmarked\to/r#2\t-\t-\ncleared\to/r#3\n"
+	if buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 	"github.com/mattn/go-isatty"
+	"github.com/srnnkls/gh-review/internal/diff"
 )
 
 var (
@@ -28,9 +29,21 @@ var (
 	dimStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("240"))
 
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9"))
+
 	authorStyle = lipgloss.NewStyle().
 			Bold(true).
 			Foreground(lipgloss.Color("14"))
+
+	hunkHeaderStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("6"))
+
+	addedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("2"))
+
+	deletedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("1"))
 )
 
 type tableFormatter struct {
@@ -56,6 +69,20 @@ func (f *tableFormatter) Format(result Result) error {
 		return f.formatView(r)
 	case AddResult:
 		return f.formatAdd(r)
+	case BatchAddResult:
+		return f.formatBatchAdd(r)
+	case ReviewsResult:
+		return f.formatReviews(r)
+	case InboxResult:
+		return f.formatInbox(r)
+	case WatchEvent:
+		return f.formatWatchEvent(r)
+	case MarkReadResult:
+		return f.formatMarkRead(r)
+	case DismissResult:
+		return f.formatDismiss(r)
+	case RequestResult:
+		return f.formatRequest(r)
 	case EditResult:
 		return f.formatEdit(r)
 	case DeleteResult:
@@ -66,8 +93,18 @@ func (f *tableFormatter) Format(result Result) error {
 		return f.formatDiscard(r)
 	case ReplyResult:
 		return f.formatReply(r)
+	case ReactResult:
+		return f.formatReact(r)
+	case CommentResult:
+		return f.formatComment(r)
 	case ResolveResult:
 		return f.formatResolve(r)
+	case ThreadStateResult:
+		return f.formatThreadState(r)
+	case ApplyResult:
+		return f.formatApply(r)
+	case HideResult:
+		return f.formatHide(r)
 	case NoOpResult:
 		return f.formatNoOp(r)
 	default:
@@ -90,6 +127,9 @@ func (f *tableFormatter) formatComments(r CommentsResult) error {
 			fmt.Fprintln(f.w, authorHeader)
 		}
 
+		withRefs := hasRefs(group.Comments)
+		withReactions := hasReactions(group.Comments)
+		withTimes := hasTimes(group.Comments)
 		rows := make([][]string, len(group.Comments))
 		for j, c := range group.Comments {
 			bodyPreview := c.Body
@@ -97,6 +137,10 @@ func (f *tableFormatter) formatComments(r CommentsResult) error {
 				bodyPreview = bodyPreview[:40] + "..."
 			}
 			bodyPreview = strings.ReplaceAll(bodyPreview, "\n", " ")
+			bodyPreview = hiddenLabel(c.Minimized, c.MinimizedReason) + bodyPreview
+			if c.ReplyTo != "" {
+				bodyPreview = "↳ " + bodyPreview
+			}
 
 			location := "(global)"
 			if c.Path != "" {
@@ -104,17 +148,29 @@ func (f *tableFormatter) formatComments(r CommentsResult) error {
 				if len(pathShort) > 20 {
 					pathShort = "..." + pathShort[len(pathShort)-17:]
 				}
-				if c.Line > 0 {
+				if c.FileLevel {
+					location = fileLocation(pathShort)
+				} else if c.Line > 0 {
 					location = fmt.Sprintf("%s:%d", pathShort, c.Line)
 				} else {
 					location = pathShort
 				}
 			}
 
-			row := []string{c.State, location, bodyPreview}
+			row := []string{c.State, location}
+			if withTimes {
+				row = append(row, activityTime(c.CreatedAt, "2006-01-02 15:04"))
+			}
+			row = append(row, bodyPreview)
+			if withReactions {
+				row = append(row, reactionSummary(c.Reactions))
+			}
 			if r.IncludeIDs {
 				row = append([]string{c.ID}, row...)
 			}
+			if withRefs {
+				row = append([]string{refOrDash(c.Ref)}, row...)
+			}
 			// In flat mode, include author per row
 			if group.Author == "" && c.Author != "" {
 				row = append(row, c.Author)
@@ -122,10 +178,20 @@ func (f *tableFormatter) formatComments(r CommentsResult) error {
 			rows[j] = row
 		}
 
-		headers := []string{"State", "Location", "Body"}
+		headers := []string{"State", "Location"}
+		if withTimes {
+			headers = append(headers, "Created")
+		}
+		headers = append(headers, "Body")
+		if withReactions {
+			headers = append(headers, "Reactions")
+		}
 		if r.IncludeIDs {
 			headers = append([]string{"ID"}, headers...)
 		}
+		if withRefs {
+			headers = append([]string{"Ref"}, headers...)
+		}
 		if group.Author == "" {
 			headers = append(headers, "Author")
 		}
@@ -162,9 +228,15 @@ func (f *tableFormatter) formatView(r ViewResult) error {
 			status = "unresolved"
 		}
 
-		location := thread.Path
-		if thread.Line > 0 {
-			location = fmt.Sprintf("%s:%d", thread.Path, thread.Line)
+		location := thread.location()
+		if thread.Ref != "" {
+			location = thread.Ref + " " + location
+		}
+		if thread.Outdated {
+			location += " (outdated)"
+		}
+		if !thread.LastActivity.IsZero() {
+			location += " · " + activityTime(thread.LastActivity, "2006-01-02 15:04")
 		}
 
 		header := fmt.Sprintf("[%s] %s", status, location)
@@ -180,12 +252,31 @@ func (f *tableFormatter) formatView(r ViewResult) error {
 		}
 		fmt.Fprintln(f.w, header)
 
+		// Code under discussion
+		for _, line := range thread.contextLines(r.Context) {
+			fmt.Fprintln(f.w, "  │ "+f.renderDiffLine(line))
+		}
+
 		// Comments
 		for _, c := range thread.Comments {
 			prefix := "  "
-			line := fmt.Sprintf("%s@%s: %s", prefix, c.Author, truncateBody(c.Body, 60))
+			if c.Ref != "" {
+				prefix += c.Ref + " "
+			}
+			author := "@" + c.Author
+			if !c.CreatedAt.IsZero() {
+				author += " · " + activityTime(c.CreatedAt, "2006-01-02 15:04")
+			}
+			hidden := hiddenLabel(c.Minimized, c.MinimizedReason)
+			line := fmt.Sprintf("%s%s: %s%s", prefix, author, hidden, truncateBody(c.Body, 60))
 			if r.IncludeIDs {
-				line = fmt.Sprintf("%s[%s] @%s: %s", prefix, c.ID, c.Author, truncateBody(c.Body, 50))
+				line = fmt.Sprintf("%s[%s] %s: %s%s", prefix, c.ID, author, hidden, truncateBody(c.Body, 50))
+			}
+			if len(c.Reactions) > 0 {
+				line += "  " + reactionSummary(c.Reactions)
+			}
+			if f.isTTY && c.Minimized {
+				line = dimStyle.Render(line)
 			}
 			fmt.Fprintln(f.w, line)
 		}
@@ -193,6 +284,23 @@ func (f *tableFormatter) formatView(r ViewResult) error {
 	return nil
 }
 
+// renderDiffLine colors a unified diff line by its kind: hunk headers,
+// additions, deletions and context.
+func (f *tableFormatter) renderDiffLine(line string) string {
+	if !f.isTTY {
+		return line
+	}
+	switch {
+	case strings.HasPrefix(line, "@@"):
+		return hunkHeaderStyle.Render(line)
+	case strings.HasPrefix(line, "+"):
+		return addedStyle.Render(line)
+	case strings.HasPrefix(line, "-"):
+		return deletedStyle.Render(line)
+	}
+	return dimStyle.Render(line)
+}
+
 func truncateBody(s string, maxLen int) string {
 	s = strings.ReplaceAll(s, "\n", " ")
 	if len(s) > maxLen {
@@ -202,7 +310,190 @@ func truncateBody(s string, maxLen int) string {
 }
 
 func (f *tableFormatter) formatAdd(r AddResult) error {
-	msg := fmt.Sprintf("✓ Added comment at %s:%d", r.Path, r.Line)
+	msg := fmt.Sprintf("✓ Added comment at %s", commentLocation(r.Path, r.Line, r.FileLevel))
+	if f.isTTY {
+		msg = successStyle.Render(msg)
+	}
+	fmt.Fprintln(f.w, msg)
+	return nil
+}
+
+func (f *tableFormatter) formatBatchAdd(r BatchAddResult) error {
+	for _, item := range r.Items {
+		if item.Error != "" {
+			msg := fmt.Sprintf("✗ Record %d (%s): %s", item.Record, commentLocation(item.Path, item.Line, item.FileLevel), item.Error)
+			if f.isTTY {
+				msg = errorStyle.Render(msg)
+			}
+			fmt.Fprintln(f.w, msg)
+			continue
+		}
+		if item.Skipped != "" {
+			msg := fmt.Sprintf("- Skipped %s (%s)", commentLocation(item.Path, item.Line, item.FileLevel), item.Skipped)
+			if f.isTTY {
+				msg = dimStyle.Render(msg)
+			}
+			fmt.Fprintln(f.w, msg)
+			continue
+		}
+		msg := fmt.Sprintf("✓ Added comment at %s", commentLocation(item.Path, item.Line, item.FileLevel))
+		if f.isTTY {
+			msg = successStyle.Render(msg)
+		}
+		fmt.Fprintln(f.w, msg)
+	}
+
+	summary := fmt.Sprintf("%d of %d comments added", r.Added(), len(r.Items))
+	if skipped := r.Skipped(); skipped > 0 {
+		summary = fmt.Sprintf("%s, %d skipped", summary, skipped)
+	}
+	if r.ReviewID != "" {
+		summary = fmt.Sprintf("%s to pending review %s", summary, r.ReviewID)
+	}
+	if f.isTTY {
+		summary = dimStyle.Render(summary)
+	}
+	fmt.Fprintln(f.w, summary)
+	return nil
+}
+
+func (f *tableFormatter) formatReviews(r ReviewsResult) error {
+	if len(r.Reviews) == 0 {
+		fmt.Fprintln(f.w, "No reviews")
+		return nil
+	}
+
+	rows := make([][]string, len(r.Reviews))
+	for i, review := range r.Reviews {
+		row := []string{
+			"@" + review.Author,
+			review.State,
+			submittedTime(review.SubmittedAt, "2006-01-02 15:04"),
+			fmt.Sprintf("%d", review.Comments),
+			shortOID(review.CommitOID),
+			truncateBody(review.Body, 40),
+		}
+		if r.IncludeIDs {
+			row = append([]string{review.ID}, row...)
+		}
+		rows[i] = row
+	}
+
+	headers := []string{"Reviewer", "State", "Submitted", "Comments", "Commit", "Body"}
+	if r.IncludeIDs {
+		headers = append([]string{"ID"}, headers...)
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("238"))).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return headerStyle
+			}
+			if row%2 == 0 {
+				return evenRowStyle
+			}
+			return oddRowStyle
+		})
+
+	fmt.Fprintln(f.w, t)
+	return nil
+}
+
+func (f *tableFormatter) formatInbox(r InboxResult) error {
+	if len(r.Items) == 0 {
+		fmt.Fprintln(f.w, "No PRs awaiting you")
+		return nil
+	}
+
+	rows := make([][]string, len(r.Items))
+	for i, item := range r.Items {
+		requested := "-"
+		if item.ReviewRequested {
+			requested = "yes"
+		}
+		rows[i] = []string{
+			item.PRRef,
+			truncateBody(item.Title, 40),
+			"@" + item.Author,
+			requested,
+			fmt.Sprintf("%d", item.Drafts),
+			fmt.Sprintf("%d", item.Unanswered),
+			item.UpdatedAt.Format("2006-01-02 15:04"),
+		}
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("238"))).
+		Headers("PR", "Title", "Author", "Requested", "Drafts", "Unanswered", "Updated").
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return headerStyle
+			}
+			if row%2 == 0 {
+				return evenRowStyle
+			}
+			return oddRowStyle
+		})
+
+	fmt.Fprintln(f.w, t)
+	return nil
+}
+
+func (f *tableFormatter) formatWatchEvent(e WatchEvent) error {
+	where := commentLocation(e.Path, e.Line, e.FileLevel)
+	var msg string
+	switch e.Kind {
+	case EventComment:
+		msg = fmt.Sprintf("@%s commented on %s", e.Author, where)
+	case EventReply:
+		msg = fmt.Sprintf("@%s replied on %s", e.Author, where)
+	case EventDiscussion:
+		msg = fmt.Sprintf("@%s commented on the PR", e.Author)
+	case EventResolved:
+		msg = fmt.Sprintf("Thread %s on %s resolved", e.ThreadRef, where)
+	case EventUnresolved:
+		msg = fmt.Sprintf("Thread %s on %s reopened", e.ThreadRef, where)
+	case EventReview:
+		msg = fmt.Sprintf("@%s submitted a review: %s", e.Author, e.State)
+	case EventDismissed:
+		msg = fmt.Sprintf("Review by @%s dismissed", e.Author)
+	default:
+		msg = e.Kind
+	}
+	if e.CommentRef != "" {
+		msg += " (" + e.CommentRef + ")"
+	}
+	if body := truncateBody(e.Body, 60); body != "" {
+		msg += ": " + body
+	}
+
+	stamp := e.Time.Format("15:04:05")
+	if f.isTTY {
+		stamp = dimStyle.Render(stamp)
+		if e.Kind == EventResolved || e.Kind == EventDismissed {
+			msg = dimStyle.Render(msg)
+		}
+	}
+	fmt.Fprintf(f.w, "%s %s\n", stamp, msg)
+	return nil
+}
+
+func (f *tableFormatter) formatMarkRead(r MarkReadResult) error {
+	var msg string
+	switch {
+	case r.Cleared:
+		msg = fmt.Sprintf("✓ Cleared read state of %s", r.PRRef)
+	case r.CommentID == "":
+		msg = fmt.Sprintf("✓ Marked %s read (no comments yet)", r.PRRef)
+	default:
+		msg = fmt.Sprintf("✓ Marked %s read up to %s (%s)", r.PRRef, r.At.Format("2006-01-02 15:04"), r.CommentID)
+	}
 	if f.isTTY {
 		msg = successStyle.Render(msg)
 	}
@@ -210,6 +501,34 @@ func (f *tableFormatter) formatAdd(r AddResult) error {
 	return nil
 }
 
+func (f *tableFormatter) formatDismiss(r DismissResult) error {
+	msg := fmt.Sprintf("✓ Dismissed review %s", r.ReviewID)
+	if r.Author != "" {
+		msg += " by @" + r.Author
+	}
+	if f.isTTY {
+		msg = successStyle.Render(msg)
+	}
+	fmt.Fprintln(f.w, msg)
+	return nil
+}
+
+func (f *tableFormatter) formatRequest(r RequestResult) error {
+	msg := fmt.Sprintf("✓ Requested review from %s on %s", reviewerNames(r.Requested), r.PRRef)
+	if f.isTTY {
+		msg = successStyle.Render(msg)
+	}
+	fmt.Fprintln(f.w, msg)
+	if len(r.Pending) > 0 {
+		pending := "Awaiting review from " + reviewerNames(r.Pending)
+		if f.isTTY {
+			pending = dimStyle.Render(pending)
+		}
+		fmt.Fprintln(f.w, pending)
+	}
+	return nil
+}
+
 func (f *tableFormatter) formatEdit(r EditResult) error {
 	msg := fmt.Sprintf("✓ Updated comment %s", r.CommentID)
 	if f.isTTY {
@@ -248,6 +567,47 @@ func (f *tableFormatter) formatDiscard(r DiscardResult) error {
 
 func (f *tableFormatter) formatReply(r ReplyResult) error {
 	msg := fmt.Sprintf("✓ Replied to thread %s", r.ThreadID)
+	if r.Draft {
+		msg = fmt.Sprintf("✓ Drafted reply to thread %s (pending until you submit the review)", r.ThreadID)
+	}
+	if f.isTTY {
+		msg = successStyle.Render(msg)
+	}
+	fmt.Fprintln(f.w, msg)
+	if r.URL != "" {
+		url := r.URL
+		if f.isTTY {
+			url = dimStyle.Render(url)
+		}
+		fmt.Fprintln(f.w, url)
+	}
+	return nil
+}
+
+func (f *tableFormatter) formatReact(r ReactResult) error {
+	for _, item := range r.Items {
+		if item.Error != "" {
+			msg := fmt.Sprintf("✗ %s: %s", item.CommentID, item.Error)
+			if f.isTTY {
+				msg = errorStyle.Render(msg)
+			}
+			fmt.Fprintln(f.w, msg)
+			continue
+		}
+		msg := fmt.Sprintf("✓ Reacted %s to comment %s", r.Emoji, item.CommentID)
+		if r.Removed {
+			msg = fmt.Sprintf("✓ Removed %s from comment %s", r.Emoji, item.CommentID)
+		}
+		if f.isTTY {
+			msg = successStyle.Render(msg)
+		}
+		fmt.Fprintln(f.w, msg)
+	}
+	return nil
+}
+
+func (f *tableFormatter) formatComment(r CommentResult) error {
+	msg := fmt.Sprintf("✓ Commented on %s", r.PRRef)
 	if f.isTTY {
 		msg = successStyle.Render(msg)
 	}
@@ -275,6 +635,138 @@ func (f *tableFormatter) formatResolve(r ResolveResult) error {
 	return nil
 }
 
+func (f *tableFormatter) formatThreadState(r ThreadStateResult) error {
+	verb, state := "Resolved", "resolved"
+	if !r.Resolved {
+		verb, state = "Unresolved", "unresolved"
+	}
+
+	for _, item := range r.Items {
+		label := item.ThreadID
+		if item.Path != "" {
+			label = fmt.Sprintf("%s (%s)", item.ThreadID, threadLocation(item.Path, item.Line))
+		}
+		if item.Error != "" {
+			msg := fmt.Sprintf("✗ %s: %s", label, item.Error)
+			if f.isTTY {
+				msg = errorStyle.Render(msg)
+			}
+			fmt.Fprintln(f.w, msg)
+			continue
+		}
+		msg := fmt.Sprintf("✓ %s thread %s", verb, label)
+		if f.isTTY {
+			msg = successStyle.Render(msg)
+		}
+		fmt.Fprintln(f.w, msg)
+	}
+
+	summary := fmt.Sprintf("%d of %d threads %s", len(r.Items)-r.Failed(), len(r.Items), state)
+	if f.isTTY {
+		summary = dimStyle.Render(summary)
+	}
+	fmt.Fprintln(f.w, summary)
+	return nil
+}
+
+func (f *tableFormatter) formatHide(r HideResult) error {
+	verb, state := "Hid", "hidden"
+	if !r.Hidden {
+		verb, state = "Unhid", "shown"
+	}
+
+	for _, item := range r.Items {
+		label := item.CommentID
+		if item.Ref != "" {
+			label = item.Ref
+		}
+		if item.Author != "" {
+			label += " by @" + item.Author
+		}
+		if item.Path != "" {
+			label += " on " + threadLocation(item.Path, item.Line)
+		}
+		if item.Error != "" {
+			msg := fmt.Sprintf("✗ %s: %s", label, item.Error)
+			if f.isTTY {
+				msg = errorStyle.Render(msg)
+			}
+			fmt.Fprintln(f.w, msg)
+			continue
+		}
+		msg := fmt.Sprintf("✓ %s comment %s", verb, label)
+		if f.isTTY {
+			msg = successStyle.Render(msg)
+		}
+		fmt.Fprintln(f.w, msg)
+	}
+
+	summary := fmt.Sprintf("%d of %d comments %s", len(r.Items)-r.Failed(), len(r.Items), state)
+	if r.Hidden && r.Reason != "" {
+		summary += " as " + r.Reason
+	}
+	if f.isTTY {
+		summary = dimStyle.Render(summary)
+	}
+	fmt.Fprintln(f.w, summary)
+	return nil
+}
+
+func (f *tableFormatter) formatApply(r ApplyResult) error {
+	verb := "Applied"
+	if r.DryRun {
+		verb = "Would apply"
+	}
+
+	for _, item := range r.Items {
+		loc := fmt.Sprintf("%s:%s", item.Path, diff.LineRange(item.StartLine, item.Line))
+		if !item.Applied {
+			msg := fmt.Sprintf("✗ %s (%s): %s", loc, item.ThreadID, item.Error)
+			if f.isTTY {
+				msg = errorStyle.Render(msg)
+			}
+			fmt.Fprintln(f.w, msg)
+			continue
+		}
+
+		msg := fmt.Sprintf("✓ %s suggestion at %s", verb, loc)
+		var done []string
+		if item.Replied {
+			done = append(done, "replied")
+		}
+		if item.Resolved {
+			done = append(done, "resolved")
+		}
+		if len(done) > 0 {
+			msg = fmt.Sprintf("%s (%s)", msg, strings.Join(done, ", "))
+		}
+		if f.isTTY {
+			msg = successStyle.Render(msg)
+		}
+		fmt.Fprintln(f.w, msg)
+		if item.Error != "" {
+			warn := fmt.Sprintf("  ✗ %s", item.Error)
+			if f.isTTY {
+				warn = errorStyle.Render(warn)
+			}
+			fmt.Fprintln(f.w, warn)
+		}
+	}
+
+	summary := fmt.Sprintf("%d of %d suggestions applied", r.Applied(), len(r.Items))
+	if r.DryRun {
+		summary = fmt.Sprintf("%d of %d suggestions would apply (dry run)", r.Applied(), len(r.Items))
+	}
+	if r.Commit != "" {
+		summary = fmt.Sprintf("%s in %s", summary, r.Commit)
+	}
+	if f.isTTY {
+		summary = dimStyle.Render(summary)
+	}
+	fmt.Fprintln(f.w, summary)
+	return nil
+}
+
 func (f *tableFormatter) formatNoOp(r NoOpResult) error {
 	msg := r.Message
 	if f.isTTY {
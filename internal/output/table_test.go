@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestTruncateBody(t *testing.T) {
@@ -282,6 +283,96 @@ func TestTableFormatterAddResult(t *testing.T) {
 	}
 }
 
+func TestTableFormatterBatchAddResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := BatchAddResult{
+		ReviewID: "PRR_1",
+		Items: []BatchAddItem{
+			{Record: 1, Path: "main.go", Line: 42, ThreadID: "PRRT_1"},
+			{Record: 2, Path: "util.go", Line: 7, Error: "line must be positive"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+
+	if !strings.Contains(output, "Added comment at main.go:42") {
+		t.Error("output should contain the added comment")
+	}
+	if !strings.Contains(output, "util.go:7") || !strings.Contains(output, "line must be positive") {
+		t.Error("output should contain the failed record and its error")
+	}
+	if !strings.Contains(output, "1 of 2 comments added") {
+		t.Error("output should contain the summary")
+	}
+}
+
+func TestTableFormatterThreadStateResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := ThreadStateResult{
+		Resolved: false,
+		Items: []ThreadStateItem{
+			{ThreadID: "PRRT_1", Path: "main.go", Line: 42},
+			{ThreadID: "PRRT_2", Error: "not found"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+
+	if !strings.Contains(output, "Unresolved thread PRRT_1 (main.go:42)") {
+		t.Error("output should contain the unresolved thread and its location")
+	}
+	if !strings.Contains(output, "PRRT_2: not found") {
+		t.Error("output should contain the failed thread and its error")
+	}
+	if !strings.Contains(output, "1 of 2 threads unresolved") {
+		t.Error("output should contain the summary")
+	}
+}
+
+func TestTableFormatterApplyResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := ApplyResult{
+		DryRun: true,
+		Items: []ApplyItem{
+			{ThreadID: "PRRT_1", Path: "main.go", StartLine: 8, Line: 10, Applied: true},
+			{ThreadID: "PRRT_2", Path: "util.go", Line: 3, Error: "changed"},
+		},
+	}
+
+	err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+
+	if !strings.Contains(output, "Would apply suggestion at main.go:8-10") {
+		t.Error("output should contain the applicable suggestion")
+	}
+	if !strings.Contains(output, "util.go:3 (PRRT_2): changed") {
+		t.Error("output should contain the conflict")
+	}
+	if !strings.Contains(output, "1 of 2 suggestions would apply (dry run)") {
+		t.Error("output should contain the dry-run summary")
+	}
+}
+
 func TestTableFormatterEditResult(t *testing.T) {
 	var buf bytes.Buffer
 	formatter := newTableFormatter(&buf)
@@ -483,3 +574,485 @@ func TestTableFormatterGlobalComment(t *testing.T) {
 		t.Error("comment without path should show '(global)'")
 	}
 }
+
+func TestTableFormatterViewResultWithRefs(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := ViewResult{
+		PRRef: "owner/repo#456",
+		Threads: []ViewThread{
+			{
+				Ref:  "t3",
+				Path: "main.go",
+				Line: 20,
+				Comments: []ViewThreadComment{
+					{Ref: "c3.1", Author: "user", Body: "comment"},
+				},
+			},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "t3 main.go:20") {
+		t.Errorf("output should contain the thread ref, got:\n%s", output)
+	}
+	if !strings.Contains(output, "c3.1 @user") {
+		t.Errorf("output should contain the comment ref, got:\n%s", output)
+	}
+}
+
+func TestTableFormatterViewResultWithContext(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := ViewResult{
+		PRRef:   "owner/repo#456",
+		Context: 2,
+		Threads: []ViewThread{
+			{
+				Path:         "main.go",
+				OriginalLine: 3,
+				Outdated:     true,
+				DiffHunk:     "@@ -1,3 +1,3 @@\n package main\n-var a = 1\n+var a = 2",
+				Comments:     []ViewThreadComment{{Author: "user", Body: "Why 2?"}},
+			},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"main.go:3 (outdated)", "│ @@ -1,3 +1,3 @@", "│ -var a = 1", "│ +var a = 2"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "package main") {
+		t.Errorf("output should show only the last 2 hunk lines:\n%s", output)
+	}
+}
+
+func TestTableFormatterReplyResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		result ReplyResult
+		want   string
+	}{
+		{"posted", ReplyResult{ThreadID: "PRRT_1"}, "Replied to thread PRRT_1"},
+		{"draft", ReplyResult{ThreadID: "PRRT_1", Draft: true}, "Drafted reply to thread PRRT_1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := newTableFormatter(&buf).Format(tt.result); err != nil {
+				t.Fatalf("Format() error: %v", err)
+			}
+			if !strings.Contains(buf.String(), tt.want) {
+				t.Errorf("output = %q, want containing %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTableFormatterMarksReplies(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := CommentsResult{
+		Groups: []CommentGroup{{
+			Author: "me",
+			Comments: []*Comment{
+				{State: "pending", Path: "a.go", Line: 1, Body: "Will fix", ReplyTo: "c1.1"},
+			},
+		}},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "↳ Will fix") {
+		t.Errorf("output should mark replies:\n%s", buf.String())
+	}
+}
+
+func TestTableFormatterFileLevel(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := newTableFormatter(&buf).Format(AddResult{Path: "logo.png", FileLevel: true}); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+		if !strings.Contains(buf.String(), "Added comment at logo.png (file)") {
+			t.Errorf("output = %q, want the file-level label", buf.String())
+		}
+	})
+
+	t.Run("comments", func(t *testing.T) {
+		var buf bytes.Buffer
+		result := CommentsResult{Groups: []CommentGroup{{
+			Author:   "user",
+			Comments: []*Comment{{State: "commented", Path: "logo.png", Body: "SVG?", FileLevel: true}},
+		}}}
+		if err := newTableFormatter(&buf).Format(result); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+		if !strings.Contains(buf.String(), "logo.png (file)") {
+			t.Errorf("output should label file-level comments:\n%s", buf.String())
+		}
+	})
+}
+
+func TestTableFormatterHideResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := HideResult{
+		Hidden: true,
+		Reason: "outdated",
+		Items: []HideItem{
+			{CommentID: "PRRC_1", Ref: "c3.2", Author: "octocat", Path: "main.go", Line: 42},
+			{CommentID: "IC_1", Error: "forbidden"},
+		},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+
+	if !strings.Contains(output, "Hid comment c3.2 by @octocat on main.go:42") {
+		t.Errorf("output should contain the hidden comment, got:\n%s", output)
+	}
+	if !strings.Contains(output, "IC_1: forbidden") {
+		t.Error("output should contain the failed comment and its error")
+	}
+	if !strings.Contains(output, "1 of 2 comments hidden as outdated") {
+		t.Error("output should contain the summary")
+	}
+}
+
+func TestTableFormatterViewResultHidden(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := ViewResult{
+		PRRef: "owner/repo#1",
+		Threads: []ViewThread{{
+			ID:   "PRRT_1",
+			Path: "main.go",
+			Line: 10,
+			Comments: []ViewThreadComment{
+				{Author: "octocat", Body: "Old note", Minimized: true, MinimizedReason: "outdated"},
+				{Author: "hubot", Body: "Still relevant"},
+			},
+		}},
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "@octocat: [hidden: outdated] Old note") {
+		t.Errorf("output should label the hidden comment, got:\n%s", output)
+	}
+	if strings.Contains(output, "[hidden] Still relevant") || strings.Contains(output, "hidden: outdated] Still") {
+		t.Error("output should not label shown comments")
+	}
+}
+
+func TestTableFormatterCommentResult(t *testing.T) {
+	var buf bytes.Buffer
+	result := CommentResult{PRRef: "owner/repo#1", CommentID: "IC_1", URL: "https://github.com/owner/repo/pull/1#issuecomment-1"}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "Commented on owner/repo#1") {
+		t.Errorf("output = %q, want the PR commented on", output)
+	}
+	if !strings.Contains(output, result.URL) {
+		t.Errorf("output = %q, want the comment URL", output)
+	}
+}
+
+func TestTableFormatterReactions(t *testing.T) {
+	reactions := []Reaction{{Name: "+1", Emoji: "👍", Count: 2, Viewer: true}, {Name: "eyes", Emoji: "👀", Count: 1}}
+
+	t.Run("comments", func(t *testing.T) {
+		var buf bytes.Buffer
+		result := CommentsResult{Groups: []CommentGroup{{
+			Author: "octocat",
+			Comments: []*Comment{
+				{State: "commented", Body: "Acknowledged", Reactions: reactions},
+				{State: "commented", Body: "No reactions"},
+			},
+		}}}
+		if err := newTableFormatter(&buf).Format(result); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+		output := buf.String()
+		if !strings.Contains(output, "Reactions") || !strings.Contains(output, "👍 2* 👀 1") {
+			t.Errorf("output should include the reactions column, got:\n%s", output)
+		}
+	})
+
+	t.Run("view", func(t *testing.T) {
+		var buf bytes.Buffer
+		result := ViewResult{Threads: []ViewThread{{
+			ID:       "PRRT_1",
+			Path:     "main.go",
+			Line:     1,
+			Comments: []ViewThreadComment{{Author: "octocat", Body: "Fix this", Reactions: reactions}},
+		}}}
+		if err := newTableFormatter(&buf).Format(result); err != nil {
+			t.Fatalf("Format() error: %v", err)
+		}
+		if !strings.Contains(buf.String(), "@octocat: Fix this  👍 2* 👀 1") {
+			t.Errorf("output should follow the comment with its reactions, got:\n%s", buf.String())
+		}
+	})
+}
+
+func TestTableFormatterReactResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		result ReactResult
+		want   []string
+	}{
+		{"added", ReactResult{Reaction: "+1", Emoji: "👍", Items: []ReactItem{{CommentID: "PRRC_1"}, {CommentID: "IC_1", Error: "forbidden"}}},
+			[]string{"Reacted 👍 to comment PRRC_1", "IC_1: forbidden"}},
+		{"removed", ReactResult{Reaction: "eyes", Emoji: "👀", Removed: true, Items: []ReactItem{{CommentID: "PRRC_1"}}},
+			[]string{"Removed 👀 from comment PRRC_1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := newTableFormatter(&buf).Format(tt.result); err != nil {
+				t.Fatalf("Format() error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output = %q, want containing %q", buf.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestTableFormatterReviewsResult(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := newTableFormatter(&buf)
+
+	result := ReviewsResult{
+		PRRef: "owner/repo#1",
+		Reviews: []ReviewItem{
+			{ID: "PRR_1", Author: "octocat", State: "approved", Body: "LGTM", SubmittedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), Comments: 2, CommitOID: "abcdef1234567"},
+			{ID: "PRR_2", Author: "me", State: "pending"},
+		},
+		IncludeIDs: true,
+	}
+
+	if err := formatter.Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"PRR_1", "@octocat", "approved", "2026-03-01 09:30", "abcdef1", "LGTM", "pending"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "abcdef12") {
+		t.Error("output should abbreviate the commit")
+	}
+}
+
+func TestTableFormatterReviewsResultEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTableFormatter(&buf).Format(ReviewsResult{}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No reviews") {
+		t.Errorf("output = %q, want No reviews", buf.String())
+	}
+}
+
+func TestTableFormatterDismissResult(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTableFormatter(&buf).Format(DismissResult{ReviewID: "PRR_1", Author: "octocat", State: "dismissed"}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if got, want := buf.String(), "✓ Dismissed review PRR_1 by @octocat\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestTableFormatterRequestResult(t *testing.T) {
+	var buf bytes.Buffer
+	result := RequestResult{
+		PRRef:     "owner/repo#1",
+		Requested: []string{"octocat", "acme/backend"},
+		Pending:   []string{"hubot", "octocat", "acme/backend"},
+	}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"✓ Requested review from @octocat, @acme/backend on owner/repo#1", "Awaiting review from @hubot, @octocat, @acme/backend"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestTableFormatterInboxResult(t *testing.T) {
+	var buf bytes.Buffer
+	result := InboxResult{Items: []InboxItem{
+		{PRRef: "o/r#7", Title: "Add cache", Author: "octocat", UpdatedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), ReviewRequested: true},
+		{PRRef: "o/r#9", Title: "Fix typo", Author: "me", Unanswered: 3},
+	}}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"o/r#7", "Add cache", "@octocat", "yes", "2026-03-01 09:30", "o/r#9", "Unanswered"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestTableFormatterInboxResultEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTableFormatter(&buf).Format(InboxResult{}); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No PRs awaiting you") {
+		t.Errorf("output = %q, want No PRs awaiting you", buf.String())
+	}
+}
+
+func TestTableFormatterViewResultLastActivity(t *testing.T) {
+	var buf bytes.Buffer
+	result := ViewResult{Threads: []ViewThread{
+		{Ref: "t1", Path: "main.go", Line: 20, LastActivity: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{Ref: "t2", Path: "db.go", Line: 5},
+	}}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	if want := "[unresolved] t1 main.go:20 · 2026-03-01 09:30"; lines[0] != want {
+		t.Errorf("header = %q, want %q", lines[0], want)
+	}
+	if want := "[unresolved] t2 db.go:5"; lines[2] != want {
+		t.Errorf("header without activity = %q, want %q", lines[2], want)
+	}
+}
+
+func TestTableFormatterViewResultCreatedAt(t *testing.T) {
+	var buf bytes.Buffer
+	result := ViewResult{Threads: []ViewThread{{Ref: "t1", Path: "main.go", Line: 20, Comments: []ViewThreadComment{
+		{Ref: "c1.1", Author: "a", Body: "Fix", CreatedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{Ref: "c1.2", Author: "b", Body: "Done"},
+	}}}}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "  c1.1 @a · 2026-03-01 09:30: Fix") {
+		t.Errorf("output should follow the author with the creation time, got:\n%s", out)
+	}
+	if !strings.Contains(out, "  c1.2 @b: Done") {
+		t.Errorf("output should omit unknown times, got:\n%s", out)
+	}
+}
+
+func TestTableFormatterCommentsCreatedAt(t *testing.T) {
+	var buf bytes.Buffer
+	result := CommentsResult{Groups: []CommentGroup{{Author: "a", Comments: []*Comment{
+		{State: "commented", Path: "main.go", Line: 20, Body: "Fix", CreatedAt: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)},
+	}}}}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Created") || !strings.Contains(out, "2026-03-01 09:30") {
+		t.Errorf("output should include the creation time column, got:\n%s", out)
+	}
+
+	buf.Reset()
+	result.Groups[0].Comments[0].CreatedAt = time.Time{}
+	if err := newTableFormatter(&buf).Format(result); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if strings.Contains(buf.String(), "Created") {
+		t.Errorf("output should omit the column when no time is known, got:\n%s", buf.String())
+	}
+}
+
+func TestTableFormatterWatchEvent(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		event WatchEvent
+		want  string
+	}{
+		{"reply", WatchEvent{Kind: EventReply, Time: at, CommentRef: "c1.2", Author: "octocat", Path: "main.go", Line: 20, Body: "Done"},
+			"09:30:05 @octocat replied on main.go:20 (c1.2): Done\n"},
+		{"resolved", WatchEvent{Kind: EventResolved, Time: at, ThreadRef: "t2", Path: "db.go", Line: 5},
+			"09:30:05 Thread t2 on db.go:5 resolved\n"},
+		{"review", WatchEvent{Kind: EventReview, Time: at, Author: "hubot", State: "approved"},
+			"09:30:05 @hubot submitted a review: approved\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := newTableFormatter(&buf).Format(tt.event); err != nil {
+				t.Fatalf("Format() error: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("output = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTableFormatterMarkReadResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		result MarkReadResult
+		want   string
+	}{
+		{"marked", MarkReadResult{PRRef: "o/r#1", CommentID: "IC_1", At: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)}, "✓ Marked o/r#1 read up to 2026-03-01 09:30 (IC_1)\n"},
+		{"no comments", MarkReadResult{PRRef: "o/r#1"}, "✓ Marked o/r#1 read (no comments yet)\n"},
+		{"cleared", MarkReadResult{PRRef: "o/r#1", Cleared: true}, "✓ Cleared read state of o/r#1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := newTableFormatter(&buf).Format(tt.result); err != nil {
+				t.Fatalf("Format() error: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("output = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package readstate remembers, per repository, how far the user has read
+// each pull request's comments.
+package readstate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/config"
+)
+
+// Mark records the last comment seen on a PR. Comments created after
+// CreatedAt are unread. MarkedAt is when the mark was set.
+type Mark struct {
+	CommentID string    `json:"comment_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	MarkedAt  time.Time `json:"marked_at"`
+}
+
+// Unread reports whether a comment created at t comes after the mark.
+func (m Mark) Unread(t time.Time) bool {
+	return t.After(m.CreatedAt)
+}
+
+// Store holds the marks of one repository's PRs, keyed by PR number.
+type Store struct {
+	path string
+	PRs  map[string]Mark `json:"prs"`
+}
+
+// Path returns the state file for a repository under the gh state
+// directory.
+func Path(owner, repo string) string {
+	return filepath.Join(config.StateDir(), "gh-review", "read", owner, repo+".json")
+}
+
+// Load reads the store at path. A missing file is an empty store.
+func Load(path string) (*Store, error) {
+	s := &Store{path: path, PRs: make(map[string]Mark)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state: %w", err)
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("parse state %s: %w", path, err)
+	}
+	if s.PRs == nil {
+		s.PRs = make(map[string]Mark)
+	}
+	return s, nil
+}
+
+// Get returns the mark for a PR, if it has one.
+func (s *Store) Get(number int) (Mark, bool) {
+	m, ok := s.PRs[strconv.Itoa(number)]
+	return m, ok
+}
+
+// Set records the mark for a PR.
+func (s *Store) Set(number int, m Mark) {
+	s.PRs[strconv.Itoa(number)] = m
+}
+
+// Clear forgets the mark for a PR, reporting whether it had one.
+func (s *Store) Clear(number int) bool {
+	key := strconv.Itoa(number)
+	_, ok := s.PRs[key]
+	delete(s.PRs, key)
+	return ok
+}
+
+// Save writes the store back to its file, creating directories as needed.
+// The file is replaced atomically so concurrent readers never see a
+// partial write.
+func (s *Store) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".read-*.json")
+	if err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
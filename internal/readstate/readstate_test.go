@@ -0,0 +1,74 @@
+package readstate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	s, err := Load(filepath.Join(t.TempDir(), "none.json"))
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if _, ok := s.Get(1); ok {
+		t.Error("Get() found a mark in an empty store")
+	}
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "owner", "repo.json")
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	s, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Set(12, Mark{CommentID: "PRRC_1", CreatedAt: at, MarkedAt: at.Add(time.Hour)})
+	s.Set(13, Mark{CommentID: "IC_1", CreatedAt: at})
+	if !s.Clear(13) || s.Clear(13) {
+		t.Error("Clear() should report only the first removal")
+	}
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() unexpected error: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	m, ok := loaded.Get(12)
+	if !ok || m.CommentID != "PRRC_1" || !m.CreatedAt.Equal(at) {
+		t.Errorf("Get(12) = %+v, %v", m, ok)
+	}
+	if _, ok := loaded.Get(13); ok {
+		t.Error("cleared mark was saved")
+	}
+}
+
+func TestLoadInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo.json")
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil || !strings.Contains(err.Error(), "parse state") {
+		t.Errorf("Load() error = %v, want parse error", err)
+	}
+}
+
+func TestMarkUnread(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	m := Mark{CreatedAt: at}
+
+	if m.Unread(at) {
+		t.Error("the marked comment itself should be read")
+	}
+	if !m.Unread(at.Add(time.Second)) {
+		t.Error("a later comment should be unread")
+	}
+	if !(Mark{}).Unread(at) {
+		t.Error("every comment should be unread without a mark")
+	}
+}
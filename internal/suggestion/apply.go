@@ -0,0 +1,141 @@
+package suggestion
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Change is a suggestion replacing lines StartLine through Line (1-based,
+// inclusive) of a file with Replacement, as returned by Parse. StartLine is
+// zero for single-line suggestions.
+// Original holds the lines the suggestion was written against, when known,
+// so code that moved or changed since can be detected.
+type Change struct {
+	StartLine   int
+	Line        int
+	Original    []string
+	Replacement string
+}
+
+// Outcome reports where a change landed. StartLine and Line differ from the
+// change's anchor when the original lines were found elsewhere in the file.
+type Outcome struct {
+	StartLine int
+	Line      int
+	Err       error
+}
+
+// Apply rewrites content with every change that can be placed, leaving out
+// changes whose original lines are gone, ambiguous, or overlap an earlier
+// change. Outcomes are returned in the order of changes.
+func Apply(content string, changes []Change) (string, []Outcome) {
+	crlf := strings.Contains(content, "\r\n")
+	body := strings.ReplaceAll(content, "\r\n", "\n")
+	trailingNewline := strings.HasSuffix(body, "\n")
+	body = strings.TrimSuffix(body, "\n")
+
+	var lines []string
+	if body != "" || trailingNewline {
+		lines = strings.Split(body, "\n")
+	}
+
+	outcomes := make([]Outcome, len(changes))
+	for i, c := range changes {
+		start, end, err := locate(lines, c)
+		outcomes[i] = Outcome{StartLine: start + 1, Line: end, Err: err}
+	}
+
+	// Apply bottom-up so earlier line numbers stay valid, rejecting any
+	// change that overlaps one already placed.
+	order := make([]int, 0, len(changes))
+	for i := range changes {
+		if outcomes[i].Err == nil {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return outcomes[order[a]].StartLine > outcomes[order[b]].StartLine
+	})
+
+	placedStart := len(lines) + 1
+	for _, i := range order {
+		o := &outcomes[i]
+		if o.Line >= placedStart {
+			o.Err = fmt.Errorf("lines %d-%d overlap another suggestion", o.StartLine, o.Line)
+			continue
+		}
+
+		var replacement []string
+		if text := strings.ReplaceAll(changes[i].Replacement, "\r\n", "\n"); text != "" {
+			replacement = strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+		}
+		updated := make([]string, 0, len(lines)-(o.Line-o.StartLine+1)+len(replacement))
+		updated = append(updated, lines[:o.StartLine-1]...)
+		updated = append(updated, replacement...)
+		updated = append(updated, lines[o.Line:]...)
+		lines = updated
+		placedStart = o.StartLine
+	}
+
+	out := strings.Join(lines, "\n")
+	if trailingNewline && len(lines) > 0 {
+		out += "\n"
+	}
+	if crlf {
+		out = strings.ReplaceAll(out, "\n", "\r\n")
+	}
+	return out, outcomes
+}
+
+// locate finds the zero-based start and exclusive end of the lines a change
+// replaces, preferring its anchor and falling back to a unique match of the
+// original lines anywhere in the file.
+func locate(lines []string, c Change) (int, int, error) {
+	start := c.StartLine
+	if start <= 0 {
+		start = c.Line
+	}
+	if start <= 0 || c.Line < start {
+		return 0, 0, fmt.Errorf("invalid line range %d-%d", start, c.Line)
+	}
+
+	if c.Original == nil {
+		if c.Line > len(lines) {
+			return 0, 0, fmt.Errorf("line %d is past the end of the file (%d lines)", c.Line, len(lines))
+		}
+		return start - 1, c.Line, nil
+	}
+
+	n := len(c.Original)
+	if matchAt(lines, start-1, c.Original) {
+		return start - 1, start - 1 + n, nil
+	}
+
+	var found []int
+	for i := 0; i+n <= len(lines); i++ {
+		if matchAt(lines, i, c.Original) {
+			found = append(found, i)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return 0, 0, fmt.Errorf("lines %d-%d changed since the suggestion was made", start, c.Line)
+	case 1:
+		return found[0], found[0] + n, nil
+	default:
+		return 0, 0, fmt.Errorf("lines %d-%d moved and now match %d places", start, c.Line, len(found))
+	}
+}
+
+func matchAt(lines []string, at int, want []string) bool {
+	if at < 0 || at+len(want) > len(lines) {
+		return false
+	}
+	for i, w := range want {
+		if lines[at+i] != w {
+			return false
+		}
+	}
+	return true
+}
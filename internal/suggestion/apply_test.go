@@ -0,0 +1,122 @@
+package suggestion
+
+import (
+	"strings"
+	"testing"
+)
+
+const file = "package main\n\nfunc f() {\n\ta := 1\n\treturn a\n}\n"
+
+func TestApply(t *testing.T) {
+	t.Run("replaces anchored lines", func(t *testing.T) {
+		got, outcomes := Apply(file, []Change{{
+			StartLine:   4,
+			Line:        5,
+			Original:    []string{"\ta := 1", "\treturn a"},
+			Replacement: "\treturn 1\n",
+		}})
+		if outcomes[0].Err != nil {
+			t.Fatalf("Apply() unexpected error: %v", outcomes[0].Err)
+		}
+		want := "package main\n\nfunc f() {\n\treturn 1\n}\n"
+		if got != want {
+			t.Errorf("Apply() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("follows moved lines", func(t *testing.T) {
+		moved := "// header\n" + file
+		got, outcomes := Apply(moved, []Change{{
+			Line:        4,
+			Original:    []string{"\ta := 1"},
+			Replacement: "\ta := 2\n",
+		}})
+		if outcomes[0].Err != nil {
+			t.Fatalf("Apply() unexpected error: %v", outcomes[0].Err)
+		}
+		if outcomes[0].Line != 5 {
+			t.Errorf("outcome line = %d, want 5", outcomes[0].Line)
+		}
+		if !strings.Contains(got, "\ta := 2\n") || strings.Contains(got, "\ta := 1\n") {
+			t.Errorf("Apply() = %q, want the moved line replaced", got)
+		}
+	})
+
+	t.Run("conflict when lines changed", func(t *testing.T) {
+		got, outcomes := Apply(file, []Change{{
+			Line:        4,
+			Original:    []string{"\ta := 42"},
+			Replacement: "\ta := 2\n",
+		}})
+		if outcomes[0].Err == nil {
+			t.Fatal("Apply() expected a conflict")
+		}
+		if got != file {
+			t.Errorf("Apply() changed the file despite the conflict")
+		}
+	})
+
+	t.Run("conflict when ambiguous", func(t *testing.T) {
+		_, outcomes := Apply("x\ny\nx\n", []Change{{
+			Line:        2,
+			Original:    []string{"x"},
+			Replacement: "z\n",
+		}})
+		if outcomes[0].Err == nil || !strings.Contains(outcomes[0].Err.Error(), "2 places") {
+			t.Errorf("Apply() error = %v, want an ambiguity conflict", outcomes[0].Err)
+		}
+	})
+
+	t.Run("several changes in one file", func(t *testing.T) {
+		got, outcomes := Apply(file, []Change{
+			{Line: 1, Original: []string{"package main"}, Replacement: "package lib\n"},
+			{Line: 4, Original: []string{"\ta := 1"}, Replacement: "\ta := 1\n\ta++\n"},
+		})
+		for i, o := range outcomes {
+			if o.Err != nil {
+				t.Fatalf("outcome %d unexpected error: %v", i, o.Err)
+			}
+		}
+		want := "package lib\n\nfunc f() {\n\ta := 1\n\ta++\n\treturn a\n}\n"
+		if got != want {
+			t.Errorf("Apply() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("overlapping changes", func(t *testing.T) {
+		_, outcomes := Apply(file, []Change{
+			{StartLine: 3, Line: 4, Replacement: "func f() { a := 1\n"},
+			{Line: 4, Replacement: "\ta := 2\n"},
+		})
+		if outcomes[0].Err == nil && outcomes[1].Err == nil {
+			t.Error("Apply() expected one of the overlapping changes to fail")
+		}
+	})
+
+	t.Run("deletion", func(t *testing.T) {
+		got, outcomes := Apply(file, []Change{{Line: 2, Replacement: ""}})
+		if outcomes[0].Err != nil {
+			t.Fatalf("Apply() unexpected error: %v", outcomes[0].Err)
+		}
+		if strings.HasPrefix(got, "package main\n\n") {
+			t.Errorf("Apply() = %q, want line 2 deleted", got)
+		}
+	})
+
+	t.Run("keeps crlf line endings", func(t *testing.T) {
+		got, outcomes := Apply("a\r\nb\r\n", []Change{{Line: 2, Original: []string{"b"}, Replacement: "c\n"}})
+		if outcomes[0].Err != nil {
+			t.Fatalf("Apply() unexpected error: %v", outcomes[0].Err)
+		}
+		if got != "a\r\nc\r\n" {
+			t.Errorf("Apply() = %q, want %q", got, "a\r\nc\r\n")
+		}
+	})
+
+	t.Run("past end of file", func(t *testing.T) {
+		_, outcomes := Apply(file, []Change{{Line: 40, Replacement: "x\n"}})
+		if outcomes[0].Err == nil {
+			t.Error("Apply() expected error for a line past the end")
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package suggestion
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	openFence  = regexp.MustCompile("^ {0,3}(`{3,})suggestion\\s*$")
+	closeFence = regexp.MustCompile("^ {0,3}(`{3,})\\s*$")
+)
+
+// Parse returns the replacement text of every suggestion block in a comment
+// body, in order. Each replacement line ends in a newline, so an empty
+// string deletes the commented lines. Unterminated blocks are ignored.
+func Parse(body string) []string {
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+
+	var found []string
+	for i := 0; i < len(lines); i++ {
+		m := openFence.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		fence := len(m[1])
+
+		for j := i + 1; j < len(lines); j++ {
+			if c := closeFence.FindStringSubmatch(lines[j]); c != nil && len(c[1]) >= fence {
+				var text string
+				for _, line := range lines[i+1 : j] {
+					text += line + "\n"
+				}
+				found = append(found, text)
+				i = j
+				break
+			}
+		}
+	}
+	return found
+}
+
+// OriginalLines recovers the last n lines of the new file from a comment's
+// diff hunk, which GitHub cuts off at the commented line. It returns nil when
+// the hunk holds fewer than n such lines.
+func OriginalLines(hunk string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	var right []string
+	for _, line := range strings.Split(strings.ReplaceAll(hunk, "\r\n", "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"), strings.HasPrefix(line, `\`):
+			continue
+		case strings.HasPrefix(line, "-"):
+			continue
+		case strings.HasPrefix(line, "+"), strings.HasPrefix(line, " "):
+			right = append(right, line[1:])
+		case line == "":
+			// A blank context line may lose its leading space in transit.
+			right = append(right, "")
+		}
+	}
+
+	// A trailing newline in the hunk yields one empty line too many.
+	if strings.HasSuffix(hunk, "\n") && len(right) > 0 {
+		right = right[:len(right)-1]
+	}
+	if len(right) < n {
+		return nil
+	}
+	return right[len(right)-n:]
+}
@@ -0,0 +1,95 @@
+package suggestion
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "single block with text around",
+			body: "Simpler:\n\n```suggestion\nreturn nil\n```\nThoughts?",
+			want: []string{"return nil\n"},
+		},
+		{
+			name: "deletion",
+			body: "```suggestion\n```",
+			want: []string{""},
+		},
+		{
+			name: "blank line replacement",
+			body: "```suggestion\n\n```",
+			want: []string{"\n"},
+		},
+		{
+			name: "longer fence keeps inner backticks",
+			body: "````suggestion\nx := \"```\"\n```\n````",
+			want: []string{"x := \"```\"\n```\n"},
+		},
+		{
+			name: "crlf body",
+			body: "```suggestion\r\na\r\nb\r\n```\r\n",
+			want: []string{"a\nb\n"},
+		},
+		{
+			name: "two blocks",
+			body: "```suggestion\na\n```\nor\n```suggestion\nb\n```",
+			want: []string{"a\n", "b\n"},
+		},
+		{
+			name: "other fences ignored",
+			body: "```go\nreturn nil\n```",
+			want: nil,
+		},
+		{
+			name: "unterminated",
+			body: "```suggestion\nreturn nil",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRoundTripsBlock(t *testing.T) {
+	for _, text := range []string{"return nil\n", "a\n\nb\n", "x := \"```\"\n", ""} {
+		got := Parse(Block(text))
+		if len(got) != 1 || got[0] != text {
+			t.Errorf("Parse(Block(%q)) = %q", text, got)
+		}
+	}
+}
+
+func TestOriginalLines(t *testing.T) {
+	hunk := "@@ -10,4 +10,5 @@ func f() {\n \ta := 1\n-\tb := 2\n+\tb := 3\n+\tc := 4\n \treturn a"
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{"last line", 1, []string{"\treturn a"}},
+		{"skips deleted lines", 4, []string{"\ta := 1", "\tb := 3", "\tc := 4", "\treturn a"}},
+		{"too many", 5, nil},
+		{"none", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OriginalLines(hunk, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OriginalLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
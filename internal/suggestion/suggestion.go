@@ -0,0 +1,83 @@
+// Package suggestion builds, parses and applies GitHub suggested-change
+// blocks in review comments.
+package suggestion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/srnnkls/gh-review/internal/diff"
+)
+
+// Block wraps replacement text in a ```suggestion fence. The fence is longer
+// than any backtick run in the text so the replacement cannot close it early.
+// A trailing newline is dropped; an empty replacement deletes the lines.
+func Block(replacement string) string {
+	replacement = strings.TrimSuffix(replacement, "\n")
+	replacement = strings.TrimSuffix(replacement, "\r")
+
+	fence := strings.Repeat("`", max(3, longestBacktickRun(replacement)+1))
+
+	var b strings.Builder
+	b.WriteString(fence)
+	b.WriteString("suggestion\n")
+	if replacement != "" {
+		b.WriteString(replacement)
+		b.WriteString("\n")
+	}
+	b.WriteString(fence)
+	return b.String()
+}
+
+// Append adds a suggestion block after an optional comment body.
+func Append(body, replacement string) string {
+	block := Block(replacement)
+	body = strings.TrimRight(body, "\n")
+	if strings.TrimSpace(body) == "" {
+		return block
+	}
+	return body + "\n\n" + block
+}
+
+// CheckAnchor reports whether a suggestion can replace the lines the anchor
+// covers. Suggestions rewrite the new version of the file, so both ends of
+// the range must be on the RIGHT side.
+func CheckAnchor(a diff.Anchor) error {
+	side, err := diff.ParseSide(a.Side)
+	if err != nil {
+		return err
+	}
+	if side != diff.Right {
+		return fmt.Errorf("suggestions replace lines of the new file; use --side RIGHT")
+	}
+
+	if a.StartLine > 0 {
+		if a.StartSide != "" {
+			startSide, err := diff.ParseSide(a.StartSide)
+			if err != nil {
+				return err
+			}
+			if startSide != diff.Right {
+				return fmt.Errorf("suggestions replace lines of the new file; use --start-side RIGHT")
+			}
+		}
+		if a.StartLine > a.Line {
+			return fmt.Errorf("suggested range %d-%d is reversed", a.StartLine, a.Line)
+		}
+	}
+
+	return nil
+}
+
+func longestBacktickRun(s string) int {
+	longest, run := 0, 0
+	for _, r := range s {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+			continue
+		}
+		run = 0
+	}
+	return longest
+}
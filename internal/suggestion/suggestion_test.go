@@ -0,0 +1,97 @@
+package suggestion
+
+import (
+	"testing"
+
+	"github.com/srnnkls/gh-review/internal/diff"
+)
+
+func TestBlock(t *testing.T) {
+	tests := []struct {
+		name        string
+		replacement string
+		want        string
+	}{
+		{
+			name:        "single line",
+			replacement: "return nil\n",
+			want:        "```suggestion\nreturn nil\n```",
+		},
+		{
+			name:        "multiple lines",
+			replacement: "a := 1\nb := 2",
+			want:        "```suggestion\na := 1\nb := 2\n```",
+		},
+		{
+			name:        "empty deletes lines",
+			replacement: "",
+			want:        "```suggestion\n```",
+		},
+		{
+			name:        "keeps interior blank lines",
+			replacement: "a\n\nb\n",
+			want:        "```suggestion\na\n\nb\n```",
+		},
+		{
+			name:        "longer fence around backticks",
+			replacement: "// see ```go``` docs",
+			want:        "````suggestion\n// see ```go``` docs\n````",
+		},
+		{
+			name:        "crlf line ending",
+			replacement: "x := 1\r\n",
+			want:        "```suggestion\nx := 1\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Block(tt.replacement); got != tt.want {
+				t.Errorf("Block() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppend(t *testing.T) {
+	t.Run("with body", func(t *testing.T) {
+		got := Append("Simpler:\n", "return nil")
+		want := "Simpler:\n\n```suggestion\nreturn nil\n```"
+		if got != want {
+			t.Errorf("Append() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("without body", func(t *testing.T) {
+		got := Append("  ", "return nil")
+		want := "```suggestion\nreturn nil\n```"
+		if got != want {
+			t.Errorf("Append() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestCheckAnchor(t *testing.T) {
+	tests := []struct {
+		name    string
+		anchor  diff.Anchor
+		wantErr bool
+	}{
+		{"single line right", diff.Anchor{Path: "a.go", Line: 10, Side: "RIGHT"}, false},
+		{"default side", diff.Anchor{Path: "a.go", Line: 10}, false},
+		{"multi-line right", diff.Anchor{Path: "a.go", Line: 10, Side: "RIGHT", StartLine: 8, StartSide: "RIGHT"}, false},
+		{"left side", diff.Anchor{Path: "a.go", Line: 10, Side: "LEFT"}, true},
+		{"left start side", diff.Anchor{Path: "a.go", Line: 10, Side: "RIGHT", StartLine: 8, StartSide: "LEFT"}, true},
+		{"reversed range", diff.Anchor{Path: "a.go", Line: 8, Side: "RIGHT", StartLine: 10}, true},
+		{"invalid side", diff.Anchor{Path: "a.go", Line: 10, Side: "UP"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAnchor(tt.anchor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckAnchor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,624 @@
+// Package tui implements the interactive review interface behind
+// 'gh review tui'.
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/diff"
+)
+
+// Backend performs the API calls behind the interface's actions, all on one
+// pull request. AddComment and Submit work on the viewer's pending review;
+// AddComment starts one when needed, Submit fails without one.
+type Backend interface {
+	Threads() ([]*api.Thread, error)
+	Diff() (*diff.Diff, error)
+	Reply(threadID, body string) error
+	SetResolved(threadID string, resolved bool) error
+	AddComment(anchor diff.Anchor, body string) error
+	EditComment(commentID, body string) error
+	DeleteComment(commentID string) error
+	Submit(event, body string) error
+}
+
+// Run shows the interface for the pull request titled title until the user
+// quits.
+func Run(backend Backend, title string) error {
+	_, err := tea.NewProgram(New(backend, title), tea.WithAltScreen()).Run()
+	return err
+}
+
+type mode int
+
+const (
+	modeBrowse mode = iota
+	modeCompose
+	modeConfirm
+	modeVerdict
+)
+
+type listView int
+
+const (
+	viewThreads listView = iota
+	viewFiles
+)
+
+type pane int
+
+const (
+	paneList pane = iota
+	paneDiff
+	paneConversation
+)
+
+// row is one displayed diff line. Side and Line locate it for a new
+// comment; Line is zero for hunk headers.
+type row struct {
+	Kind diff.LineKind
+	Text string
+	Side diff.Side
+	Line int
+
+	header bool
+}
+
+type loadedMsg struct {
+	threads []*api.Thread
+	diff    *diff.Diff
+	err     error
+}
+
+type doneMsg struct {
+	status string
+	err    error
+}
+
+// Model is the Bubble Tea model of the review interface.
+type Model struct {
+	backend Backend
+	title   string
+
+	threads []*api.Thread
+	diff    *diff.Diff
+	loading bool
+
+	view    listView
+	focus   pane
+	thread  int
+	file    int
+	cursor  int
+	mark    int
+	comment int
+
+	mode     mode
+	editor   textarea.Model
+	prompt   string
+	onSubmit func(body string) tea.Cmd
+	onYes    func() tea.Cmd
+
+	status   string
+	showHelp bool
+	width    int
+	height   int
+}
+
+// New returns the model for the pull request titled title.
+func New(backend Backend, title string) Model {
+	editor := textarea.New()
+	editor.ShowLineNumbers = false
+	editor.Placeholder = "Write a comment…"
+	editor.SetHeight(composeHeight)
+
+	return Model{
+		backend: backend,
+		title:   title,
+		loading: true,
+		mark:    -1,
+		editor:  editor,
+		width:   80,
+		height:  24,
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return m.load()
+}
+
+func (m Model) load() tea.Cmd {
+	return func() tea.Msg {
+		threads, err := m.backend.Threads()
+		if err != nil {
+			return loadedMsg{err: err}
+		}
+		d, err := m.backend.Diff()
+		return loadedMsg{threads: threads, diff: d, err: err}
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.editor.SetWidth(max(10, msg.Width-2))
+		return m, nil
+
+	case loadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.status = "error: " + msg.err.Error()
+			return m, nil
+		}
+		m.reload(msg.threads, msg.diff)
+		return m, nil
+
+	case doneMsg:
+		if msg.err != nil {
+			m.status = "error: " + msg.err.Error()
+			return m, nil
+		}
+		m.status = msg.status
+		m.loading = true
+		return m, m.load()
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		switch m.mode {
+		case modeCompose:
+			return m.updateCompose(msg)
+		case modeConfirm:
+			return m.updateConfirm(msg)
+		case modeVerdict:
+			return m.updateVerdict(msg)
+		default:
+			return m.updateBrowse(msg)
+		}
+	}
+
+	if m.mode == modeCompose {
+		var cmd tea.Cmd
+		m.editor, cmd = m.editor.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// reload replaces the loaded data, keeping the selected thread or file
+// when it still exists.
+func (m *Model) reload(threads []*api.Thread, d *diff.Diff) {
+	var threadID, path string
+	if t := m.selectedThread(); t != nil {
+		threadID = t.ID
+	}
+	if f := m.selectedFile(); f != nil {
+		path = f.Path
+	}
+
+	m.threads, m.diff = threads, d
+	m.thread = clamp(indexOf(len(threads), func(i int) bool { return threads[i].ID == threadID }, m.thread), len(threads))
+	m.file = clamp(indexOf(len(m.files()), func(i int) bool { return m.files()[i].Path == path }, m.file), len(m.files()))
+
+	if t, f := m.selectedThread(), m.selectedFile(); (m.view == viewThreads && (t == nil || t.ID != threadID)) ||
+		(m.view == viewFiles && (f == nil || f.Path != path)) {
+		m.resetCursor()
+		return
+	}
+	m.comment = clamp(m.comment, len(m.selectedComments()))
+	m.cursor = clamp(m.cursor, len(m.rows()))
+	m.mark = -1
+}
+
+func (m Model) updateBrowse(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "?":
+		m.showHelp = !m.showHelp
+	case "tab":
+		m.focus = m.nextPane(1)
+	case "shift+tab":
+		m.focus = m.nextPane(-1)
+	case "enter":
+		if m.focus == paneList {
+			m.focus = paneDiff
+		}
+	case "j", "down":
+		m.move(1)
+	case "k", "up":
+		m.move(-1)
+	case "g", "home":
+		m.move(-1 << 30)
+	case "G", "end":
+		m.move(1 << 30)
+	case "f":
+		m.toggleView()
+	case "R":
+		m.loading = true
+		m.status = "Refreshing…"
+		return m, m.load()
+	case "v":
+		if m.mark >= 0 {
+			m.mark = -1
+		} else {
+			m.mark = m.cursor
+		}
+	case "r":
+		return m.startReply()
+	case "x":
+		return m.toggleResolved()
+	case "c":
+		return m.startComment()
+	case "e":
+		return m.startEdit()
+	case "d":
+		return m.startDelete()
+	case "s":
+		m.mode = modeVerdict
+		m.status = ""
+	}
+	return m, nil
+}
+
+func (m Model) updateCompose(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.mode = modeBrowse
+		m.editor.Blur()
+		m.status = "Cancelled"
+		return m, nil
+	case "ctrl+s":
+		body := strings.TrimSpace(m.editor.Value())
+		cmd := m.onSubmit(body)
+		if cmd == nil {
+			m.status = "Nothing to send; write a body or press esc to cancel"
+			return m, nil
+		}
+		m.mode = modeBrowse
+		m.editor.Blur()
+		m.status = "Sending…"
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.editor, cmd = m.editor.Update(msg)
+	return m, cmd
+}
+
+func (m Model) updateConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mode = modeBrowse
+	if msg.String() == "y" || msg.String() == "Y" {
+		m.status = "Sending…"
+		return m, m.onYes()
+	}
+	m.status = "Cancelled"
+	return m, nil
+}
+
+var verdicts = map[string]string{
+	"a": "APPROVE",
+	"c": "COMMENT",
+	"r": "REQUEST_CHANGES",
+}
+
+func (m Model) updateVerdict(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	event, ok := verdicts[msg.String()]
+	if !ok {
+		m.mode = modeBrowse
+		m.status = "Cancelled"
+		return m, nil
+	}
+
+	return m.compose(fmt.Sprintf("Submit review (%s)", strings.ToLower(event)), "", func(body string) tea.Cmd {
+		return m.do(fmt.Sprintf("Review submitted (%s)", strings.ToLower(event)), func() error {
+			return m.backend.Submit(event, body)
+		})
+	}, true)
+}
+
+// compose switches to the editor; submit turns the body into the command
+// that sends it. Empty bodies are rejected unless allowEmpty is set.
+func (m Model) compose(prompt, initial string, submit func(body string) tea.Cmd, allowEmpty bool) (tea.Model, tea.Cmd) {
+	m.mode = modeCompose
+	m.prompt = prompt
+	m.status = ""
+	m.onSubmit = func(body string) tea.Cmd {
+		if body == "" && !allowEmpty {
+			return nil
+		}
+		return submit(body)
+	}
+	m.editor.SetValue(initial)
+	return m, m.editor.Focus()
+}
+
+// do runs fn in the background and reports status when it succeeds.
+func (m Model) do(status string, fn func() error) tea.Cmd {
+	return func() tea.Msg {
+		if err := fn(); err != nil {
+			return doneMsg{err: err}
+		}
+		return doneMsg{status: status}
+	}
+}
+
+func (m Model) startReply() (tea.Model, tea.Cmd) {
+	t := m.selectedThread()
+	if m.view != viewThreads || t == nil {
+		m.status = "Select a thread to reply to"
+		return m, nil
+	}
+	return m.compose("Reply on "+location(t), "", func(body string) tea.Cmd {
+		return m.do("Replied on "+location(t), func() error {
+			return m.backend.Reply(t.ID, body)
+		})
+	}, false)
+}
+
+func (m Model) toggleResolved() (tea.Model, tea.Cmd) {
+	t := m.selectedThread()
+	if m.view != viewThreads || t == nil {
+		m.status = "Select a thread to resolve"
+		return m, nil
+	}
+	resolve := !t.IsResolved
+	status := "Resolved "
+	if !resolve {
+		status = "Unresolved "
+	}
+	m.status = "Sending…"
+	return m, m.do(status+location(t), func() error {
+		return m.backend.SetResolved(t.ID, resolve)
+	})
+}
+
+func (m Model) startComment() (tea.Model, tea.Cmd) {
+	anchor, err := m.anchor()
+	if err != nil {
+		m.status = err.Error()
+		return m, nil
+	}
+	at := anchor.Path + ":" + diff.LineRange(anchor.StartLine, anchor.Line)
+	return m.compose("Comment on "+at, "", func(body string) tea.Cmd {
+		return m.do("Added draft comment on "+at, func() error {
+			return m.backend.AddComment(anchor, body)
+		})
+	}, false)
+}
+
+func (m Model) startEdit() (tea.Model, tea.Cmd) {
+	c, err := m.selectedDraft("edit")
+	if err != nil {
+		m.status = err.Error()
+		return m, nil
+	}
+	return m.compose("Edit draft comment", c.Body, func(body string) tea.Cmd {
+		return m.do("Updated draft comment", func() error {
+			return m.backend.EditComment(c.ID, body)
+		})
+	}, false)
+}
+
+func (m Model) startDelete() (tea.Model, tea.Cmd) {
+	c, err := m.selectedDraft("delete")
+	if err != nil {
+		m.status = err.Error()
+		return m, nil
+	}
+	m.mode = modeConfirm
+	m.prompt = "Delete this draft comment? [y/N]"
+	m.onYes = func() tea.Cmd {
+		return m.do("Deleted draft comment", func() error {
+			return m.backend.DeleteComment(c.ID)
+		})
+	}
+	return m, nil
+}
+
+// selectedDraft returns the selected comment of the conversation when it
+// is one of the viewer's drafts.
+func (m Model) selectedDraft(verb string) (*api.ThreadComment, error) {
+	comments := m.selectedComments()
+	if m.view != viewThreads || m.focus != paneConversation || len(comments) == 0 {
+		return nil, fmt.Errorf("select a comment in the conversation (tab) to %s it", verb)
+	}
+	c := comments[m.comment]
+	if c.State != "pending" {
+		return nil, fmt.Errorf("only draft comments in your pending review can be %sd", strings.TrimSuffix(verb, "e"))
+	}
+	return c, nil
+}
+
+// anchor places a new comment on the diff line under the cursor, or on the
+// range from the mark to the cursor.
+func (m Model) anchor() (diff.Anchor, error) {
+	rows := m.rows()
+	if m.focus != paneDiff || len(rows) == 0 {
+		return diff.Anchor{}, fmt.Errorf("move the cursor to a diff line (tab) to comment on it")
+	}
+	if t := m.selectedThread(); m.view == viewThreads && t != nil && t.IsOutdated {
+		return diff.Anchor{}, fmt.Errorf("this thread's hunk is outdated; comment from the files view (f)")
+	}
+
+	end := rows[m.cursor]
+	if end.Line == 0 {
+		return diff.Anchor{}, fmt.Errorf("move the cursor to a diff line to comment on it")
+	}
+	anchor := diff.Anchor{Path: m.selectedPath(), Line: end.Line, Side: string(end.Side)}
+
+	if m.mark >= 0 && m.mark != m.cursor {
+		first, last := min(m.mark, m.cursor), max(m.mark, m.cursor)
+		start, end := rows[first], rows[last]
+		if start.Line == 0 || end.Line == 0 {
+			return diff.Anchor{}, fmt.Errorf("a comment range cannot span hunk headers")
+		}
+		anchor.Line, anchor.Side = end.Line, string(end.Side)
+		anchor.StartLine, anchor.StartSide = start.Line, string(start.Side)
+	}
+
+	if m.diff != nil {
+		if err := m.diff.Validate(anchor); err != nil {
+			return diff.Anchor{}, err
+		}
+	}
+	return anchor, nil
+}
+
+func (m *Model) toggleView() {
+	if m.view == viewThreads {
+		m.view = viewFiles
+		if t := m.selectedThread(); t != nil {
+			m.file = clamp(indexOf(len(m.files()), func(i int) bool { return m.files()[i].Path == t.Path }, m.file), len(m.files()))
+		}
+	} else {
+		m.view = viewThreads
+	}
+	m.focus = paneList
+	m.resetCursor()
+}
+
+func (m Model) nextPane(step int) pane {
+	panes := 3
+	if m.view == viewFiles {
+		panes = 2
+	}
+	return pane((int(m.focus) + step + panes) % panes)
+}
+
+func (m *Model) move(step int) {
+	switch m.focus {
+	case paneList:
+		if m.view == viewThreads {
+			m.thread = clamp(m.thread+step, len(m.threads))
+		} else {
+			m.file = clamp(m.file+step, len(m.files()))
+		}
+		m.resetCursor()
+	case paneDiff:
+		m.cursor = clamp(m.cursor+step, len(m.rows()))
+	case paneConversation:
+		m.comment = clamp(m.comment+step, len(m.selectedComments()))
+	}
+}
+
+// resetCursor puts the diff cursor on a thread's commented line, which is
+// the last line of its hunk, or on the first line of a file.
+func (m *Model) resetCursor() {
+	m.mark = -1
+	m.comment = 0
+	m.cursor = 0
+	if m.view == viewThreads {
+		m.cursor = max(0, len(m.rows())-1)
+	}
+}
+
+func (m Model) selectedThread() *api.Thread {
+	if m.thread < len(m.threads) {
+		return m.threads[m.thread]
+	}
+	return nil
+}
+
+func (m Model) selectedComments() []*api.ThreadComment {
+	if t := m.selectedThread(); t != nil {
+		return t.Comments
+	}
+	return nil
+}
+
+// files lists the changed files that have a line diff.
+func (m Model) files() []*diff.File {
+	if m.diff == nil {
+		return nil
+	}
+	var files []*diff.File
+	for _, f := range m.diff.Files {
+		if f.HasPatch {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (m Model) selectedFile() *diff.File {
+	if files := m.files(); m.file < len(files) {
+		return files[m.file]
+	}
+	return nil
+}
+
+func (m Model) selectedPath() string {
+	if m.view == viewThreads {
+		if t := m.selectedThread(); t != nil {
+			return t.Path
+		}
+		return ""
+	}
+	if f := m.selectedFile(); f != nil {
+		return f.Path
+	}
+	return ""
+}
+
+// rows returns the diff lines shown for the selection: the selected
+// thread's hunk, or every hunk of the selected file.
+func (m Model) rows() []row {
+	if m.view == viewThreads {
+		t := m.selectedThread()
+		if t == nil {
+			return nil
+		}
+		hunks, err := diff.ParsePatch(t.DiffHunk)
+		if err != nil {
+			return nil
+		}
+		return hunkRows(hunks)
+	}
+	if f := m.selectedFile(); f != nil {
+		return hunkRows(f.Hunks)
+	}
+	return nil
+}
+
+func hunkRows(hunks []diff.Hunk) []row {
+	var rows []row
+	for _, h := range hunks {
+		rows = append(rows, row{Text: h.Header, header: true})
+		for _, l := range h.Lines {
+			r := row{Kind: l.Kind, Text: l.Text, Side: diff.Right, Line: l.NewLine}
+			if l.Kind == diff.Deleted {
+				r.Side, r.Line = diff.Left, l.OldLine
+			}
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
+
+func location(t *api.Thread) string {
+	if t.IsFileLevel {
+		return t.Path + " (file)"
+	}
+	return t.Path + ":" + diff.LineRange(t.StartLine, t.Line)
+}
+
+// indexOf returns the first index below n matching pred, or fallback.
+func indexOf(n int, pred func(int) bool, fallback int) int {
+	for i := 0; i < n; i++ {
+		if pred(i) {
+			return i
+		}
+	}
+	return fallback
+}
+
+// clamp limits i to a valid index of a list of length n, or zero.
+func clamp(i, n int) int {
+	return max(0, min(i, n-1))
+}
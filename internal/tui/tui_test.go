@@ -0,0 +1,331 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/diff"
+)
+
+type fakeBackend struct {
+	threads []*api.Thread
+	diff    *diff.Diff
+	calls   []string
+}
+
+func (b *fakeBackend) Threads() ([]*api.Thread, error) { return b.threads, nil }
+func (b *fakeBackend) Diff() (*diff.Diff, error)       { return b.diff, nil }
+
+func (b *fakeBackend) Reply(threadID, body string) error {
+	b.calls = append(b.calls, fmt.Sprintf("reply %s %q", threadID, body))
+	return nil
+}
+
+func (b *fakeBackend) SetResolved(threadID string, resolved bool) error {
+	b.calls = append(b.calls, fmt.Sprintf("resolved %s %v", threadID, resolved))
+	return nil
+}
+
+func (b *fakeBackend) AddComment(a diff.Anchor, body string) error {
+	b.calls = append(b.calls, fmt.Sprintf("add %s %s:%d-%s:%d %q", a.Path, a.StartSide, a.StartLine, a.Side, a.Line, body))
+	return nil
+}
+
+func (b *fakeBackend) EditComment(commentID, body string) error {
+	b.calls = append(b.calls, fmt.Sprintf("edit %s %q", commentID, body))
+	return nil
+}
+
+func (b *fakeBackend) DeleteComment(commentID string) error {
+	b.calls = append(b.calls, "delete "+commentID)
+	return nil
+}
+
+func (b *fakeBackend) Submit(event, body string) error {
+	b.calls = append(b.calls, fmt.Sprintf("submit %s %q", event, body))
+	return nil
+}
+
+const patch = "@@ -1,3 +1,3 @@\n package main\n-var a = 1\n+var a = 2\n func main() {}"
+
+func newTestModel(t *testing.T) (Model, *fakeBackend) {
+	t.Helper()
+
+	file, err := diff.NewFile("main.go", "", "modified", patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &fakeBackend{
+		threads: []*api.Thread{
+			{
+				ID: "PRRT_1", Path: "main.go", Line: 2, DiffHunk: "@@ -1,3 +1,3 @@\n package main\n-var a = 1\n+var a = 2",
+				Comments: []*api.ThreadComment{{ID: "PRRC_1", Author: "octocat", Body: "Why 2?", State: "commented"}},
+			},
+			{
+				ID: "PRRT_2", Path: "main.go", Line: 3, IsResolved: true, DiffHunk: patch,
+				Comments: []*api.ThreadComment{{ID: "PRRC_2", Author: "me", Body: "Draft", State: "pending"}},
+			},
+		},
+		diff: &diff.Diff{Files: []*diff.File{file}},
+	}
+
+	m := New(backend, "owner/repo#1")
+	m = update(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = update(t, m, m.Init()())
+	return m, backend
+}
+
+// update applies msg and then runs the resulting command's message, the
+// way the program loop would for a single asynchronous action.
+func update(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+	next, _ := m.Update(msg)
+	return next.(Model)
+}
+
+func press(t *testing.T, m Model, keys ...string) (Model, tea.Cmd) {
+	t.Helper()
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var next tea.Model
+		next, cmd = m.Update(keyMsg(k))
+		m = next.(Model)
+	}
+	return m, cmd
+}
+
+func keyMsg(k string) tea.KeyMsg {
+	switch k {
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "ctrl+s":
+		return tea.KeyMsg{Type: tea.KeyCtrlS}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+// run executes cmd and feeds its message back into the model.
+func run(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatalf("expected a command; status = %q", m.status)
+	}
+	msg := cmd()
+	if done, ok := msg.(doneMsg); ok && done.err != nil {
+		t.Fatalf("action failed: %v", done.err)
+	}
+	return update(t, m, msg)
+}
+
+func TestHunkRows(t *testing.T) {
+	hunks, err := diff.ParsePatch(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := hunkRows(hunks)
+
+	want := []struct {
+		side diff.Side
+		line int
+	}{
+		{"", 0},
+		{diff.Right, 1},
+		{diff.Left, 2},
+		{diff.Right, 2},
+		{diff.Right, 3},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("hunkRows() returned %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		if rows[i].Side != w.side || rows[i].Line != w.line {
+			t.Errorf("row %d = %s:%d, want %s:%d", i, rows[i].Side, rows[i].Line, w.side, w.line)
+		}
+	}
+	if !rows[0].header {
+		t.Error("first row should be the hunk header")
+	}
+}
+
+func TestWindow(t *testing.T) {
+	lines := []string{"0", "1", "2", "3", "4", "5", "6", "7"}
+
+	tests := []struct {
+		focus, height int
+		want          string
+	}{
+		{0, 3, "012"},
+		{4, 3, "345"},
+		{7, 3, "567"},
+		{2, 10, "01234567"},
+		{2, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(window(lines, tt.focus, tt.height), ""); got != tt.want {
+			t.Errorf("window(focus %d, height %d) = %q, want %q", tt.focus, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestReply(t *testing.T) {
+	m, backend := newTestModel(t)
+
+	m, _ = press(t, m, "r")
+	if m.mode != modeCompose {
+		t.Fatalf("mode = %v, want compose", m.mode)
+	}
+	m, _ = press(t, m, "Fixed, thanks")
+	m, cmd := press(t, m, "ctrl+s")
+	m = run(t, m, cmd)
+
+	if want := `reply PRRT_1 "Fixed, thanks"`; len(backend.calls) != 1 || backend.calls[0] != want {
+		t.Errorf("calls = %q, want [%s]", backend.calls, want)
+	}
+	if !m.loading {
+		t.Error("model should reload after an action")
+	}
+}
+
+func TestEmptyReplyIsNotSent(t *testing.T) {
+	m, backend := newTestModel(t)
+
+	m, cmd := press(t, m, "r", "ctrl+s")
+	if cmd != nil || m.mode != modeCompose {
+		t.Errorf("empty reply sent or editor closed (mode %v)", m.mode)
+	}
+	m, _ = press(t, m, "esc")
+	if m.mode != modeBrowse || len(backend.calls) != 0 {
+		t.Errorf("esc: mode = %v, calls = %q", m.mode, backend.calls)
+	}
+}
+
+func TestToggleResolved(t *testing.T) {
+	m, backend := newTestModel(t)
+
+	m, cmd := press(t, m, "x")
+	m = run(t, m, cmd)
+	m, cmd = press(t, m, "j", "x")
+	run(t, m, cmd)
+
+	want := []string{"resolved PRRT_1 true", "resolved PRRT_2 false"}
+	if strings.Join(backend.calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls = %q, want %q", backend.calls, want)
+	}
+}
+
+func TestCommentAtCursor(t *testing.T) {
+	t.Run("single line", func(t *testing.T) {
+		m, backend := newTestModel(t)
+
+		// The cursor starts on the thread's commented line.
+		m, _ = press(t, m, "tab", "c", "Nit")
+		m, cmd := press(t, m, "ctrl+s")
+		run(t, m, cmd)
+
+		if want := `add main.go :0-RIGHT:2 "Nit"`; len(backend.calls) != 1 || backend.calls[0] != want {
+			t.Errorf("calls = %q, want [%s]", backend.calls, want)
+		}
+	})
+
+	t.Run("range in files view", func(t *testing.T) {
+		m, backend := newTestModel(t)
+
+		m, _ = press(t, m, "f", "tab", "j", "v", "j", "j", "j", "c", "Block")
+		m, cmd := press(t, m, "ctrl+s")
+		run(t, m, cmd)
+
+		if want := `add main.go RIGHT:1-RIGHT:3 "Block"`; len(backend.calls) != 1 || backend.calls[0] != want {
+			t.Errorf("calls = %q, want [%s]", backend.calls, want)
+		}
+	})
+
+	t.Run("needs diff focus", func(t *testing.T) {
+		m, _ := newTestModel(t)
+
+		m, _ = press(t, m, "c")
+		if m.mode != modeBrowse || !strings.Contains(m.status, "tab") {
+			t.Errorf("mode = %v, status = %q; want a hint to focus the diff", m.mode, m.status)
+		}
+	})
+}
+
+func TestDrafts(t *testing.T) {
+	t.Run("submitted comments cannot be edited", func(t *testing.T) {
+		m, _ := newTestModel(t)
+
+		m, _ = press(t, m, "tab", "tab", "e")
+		if m.mode != modeBrowse || !strings.Contains(m.status, "draft") {
+			t.Errorf("mode = %v, status = %q", m.mode, m.status)
+		}
+	})
+
+	t.Run("edit prefills the body", func(t *testing.T) {
+		m, backend := newTestModel(t)
+
+		m, _ = press(t, m, "j", "tab", "tab", "e")
+		if got := m.editor.Value(); got != "Draft" {
+			t.Fatalf("editor = %q, want the current body", got)
+		}
+		m, _ = press(t, m, "!")
+		m, cmd := press(t, m, "ctrl+s")
+		run(t, m, cmd)
+
+		if want := `edit PRRC_2 "Draft!"`; len(backend.calls) != 1 || backend.calls[0] != want {
+			t.Errorf("calls = %q, want [%s]", backend.calls, want)
+		}
+	})
+
+	t.Run("delete asks first", func(t *testing.T) {
+		m, backend := newTestModel(t)
+
+		m, _ = press(t, m, "j", "tab", "tab", "d", "n")
+		if len(backend.calls) != 0 {
+			t.Fatalf("calls = %q after declining", backend.calls)
+		}
+		m, cmd := press(t, m, "d", "y")
+		run(t, m, cmd)
+
+		if want := "delete PRRC_2"; len(backend.calls) != 1 || backend.calls[0] != want {
+			t.Errorf("calls = %q, want [%s]", backend.calls, want)
+		}
+	})
+}
+
+func TestSubmit(t *testing.T) {
+	m, backend := newTestModel(t)
+
+	m, _ = press(t, m, "s", "a")
+	m, cmd := press(t, m, "ctrl+s")
+	run(t, m, cmd)
+
+	if want := `submit APPROVE ""`; len(backend.calls) != 1 || backend.calls[0] != want {
+		t.Errorf("calls = %q, want [%s]", backend.calls, want)
+	}
+}
+
+func TestView(t *testing.T) {
+	m, _ := newTestModel(t)
+
+	out := m.View()
+	for _, want := range []string{"owner/repo#1", "2 threads, 1 unresolved, 1 drafts", "main.go:2", "@octocat", "Why 2?"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	m, _ = press(t, m, "f")
+	if out := m.View(); !strings.Contains(out, "(2 threads)") {
+		t.Error("files view should count threads per file")
+	}
+
+	// Tiny terminals must not panic.
+	small := update(t, m, tea.WindowSizeMsg{Width: 10, Height: 4})
+	_ = small.View()
+}
@@ -0,0 +1,348 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/srnnkls/gh-review/internal/api"
+	"github.com/srnnkls/gh-review/internal/diff"
+)
+
+// composeHeight is the number of text lines the comment editor shows.
+const composeHeight = 6
+
+var (
+	titleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("12"))
+
+	dimStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240"))
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9"))
+
+	authorStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("14"))
+
+	draftStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("11"))
+
+	resolvedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("10"))
+
+	addedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("10"))
+
+	deletedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9"))
+
+	hunkStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("6"))
+
+	cursorStyle = lipgloss.NewStyle().
+			Reverse(true)
+
+	markStyle = lipgloss.NewStyle().
+			Background(lipgloss.Color("237"))
+
+	paneStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("240"))
+
+	focusedPaneStyle = paneStyle.
+				BorderForeground(lipgloss.Color("12"))
+)
+
+func (m Model) View() string {
+	header := titleStyle.Render(m.title) + dimStyle.Render("  "+m.summary())
+	footer := m.footer()
+
+	// Everything between the header and footer, minus pane borders.
+	body := m.height - 2 - lipgloss.Height(footer)
+	if m.mode == modeCompose {
+		body -= composeHeight + 3
+	}
+
+	items := m.listItems()
+	listHeight := max(1, min(len(items), body/3))
+	detailHeight := max(1, body-listHeight-4)
+
+	sections := []string{
+		header,
+		m.pane(paneList, m.width, listHeight, window(items, m.listIndex(), listHeight)),
+		m.detail(detailHeight),
+	}
+	if m.mode == modeCompose {
+		sections = append(sections, titleStyle.Render(m.prompt)+dimStyle.Render("  ctrl+s send · esc cancel"),
+			focusedPaneStyle.Render(m.editor.View()))
+	}
+	sections = append(sections, footer)
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m Model) summary() string {
+	if m.loading && m.threads == nil {
+		return "loading…"
+	}
+	unresolved, drafts := 0, 0
+	for _, t := range m.threads {
+		if !t.IsResolved {
+			unresolved++
+		}
+		for _, c := range t.Comments {
+			if c.State == "pending" {
+				drafts++
+			}
+		}
+	}
+	return fmt.Sprintf("%d threads, %d unresolved, %d drafts", len(m.threads), unresolved, drafts)
+}
+
+// detail renders the panes below the list: the diff and, in the threads
+// view, the conversation next to it.
+func (m Model) detail(height int) string {
+	if m.view == viewFiles {
+		return m.pane(paneDiff, m.width, height, m.diffLines(m.width-2, height))
+	}
+
+	left := m.width / 2
+	right := m.width - left
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		m.pane(paneDiff, left, height, m.diffLines(left-2, height)),
+		m.pane(paneConversation, right, height, m.conversationLines(right-2, height)),
+	)
+}
+
+// pane draws lines in a bordered box of the given outer width and inner
+// height, highlighted when it has focus.
+func (m Model) pane(p pane, width, height int, lines []string) string {
+	style := paneStyle
+	if m.focus == p && m.mode == modeBrowse {
+		style = focusedPaneStyle
+	}
+	inner := max(1, width-2)
+	for i, l := range lines {
+		lines[i] = ansi.Truncate(l, inner, "…")
+	}
+	return style.Width(inner).Height(height).MaxHeight(height + 2).Render(strings.Join(lines, "\n"))
+}
+
+func (m Model) listIndex() int {
+	if m.view == viewThreads {
+		return m.thread
+	}
+	return m.file
+}
+
+func (m Model) listItems() []string {
+	var items []string
+	if m.view == viewThreads {
+		for i, t := range m.threads {
+			items = append(items, m.listItem(i == m.thread, threadItem(t)))
+		}
+		if len(items) == 0 {
+			items = append(items, dimStyle.Render("No review threads. Press f to browse the diff."))
+		}
+		return items
+	}
+
+	counts := make(map[string]int)
+	for _, t := range m.threads {
+		counts[t.Path]++
+	}
+	for i, f := range m.files() {
+		text := fmt.Sprintf("%-8s %s", f.Status, f.Path)
+		if n := counts[f.Path]; n > 0 {
+			text += dimStyle.Render(fmt.Sprintf("  (%d threads)", n))
+		}
+		items = append(items, m.listItem(i == m.file, text))
+	}
+	if len(items) == 0 {
+		items = append(items, dimStyle.Render("No changed files with a line diff."))
+	}
+	return items
+}
+
+func (m Model) listItem(selected bool, text string) string {
+	if selected {
+		return cursorStyle.Render("> ") + text
+	}
+	return "  " + text
+}
+
+func threadItem(t *api.Thread) string {
+	mark := "●"
+	if t.IsResolved {
+		mark = resolvedStyle.Render("✓")
+	}
+	text := mark + " " + location(t)
+	if len(t.Comments) > 0 {
+		text += "  " + authorStyle.Render("@"+t.Comments[0].Author)
+		first, _, _ := strings.Cut(strings.TrimSpace(t.Comments[0].Body), "\n")
+		text += "  " + first
+	}
+
+	var tags []string
+	if n := len(t.Comments); n > 1 {
+		tags = append(tags, fmt.Sprintf("%d comments", n))
+	}
+	if t.IsOutdated {
+		tags = append(tags, "outdated")
+	}
+	for _, c := range t.Comments {
+		if c.State == "pending" {
+			tags = append(tags, draftStyle.Render("draft"))
+			break
+		}
+	}
+	if len(tags) > 0 {
+		text += dimStyle.Render("  [") + strings.Join(tags, dimStyle.Render(", ")) + dimStyle.Render("]")
+	}
+	return text
+}
+
+func (m Model) diffLines(width, height int) []string {
+	rows := m.rows()
+	if len(rows) == 0 {
+		return []string{dimStyle.Render("No diff to show.")}
+	}
+
+	first, last := m.cursor, m.cursor
+	if m.mark >= 0 {
+		first, last = min(m.mark, m.cursor), max(m.mark, m.cursor)
+	}
+
+	lines := make([]string, len(rows))
+	for i, r := range rows {
+		text := rowText(r, width)
+		switch {
+		case i == m.cursor && m.focus == paneDiff:
+			text = cursorStyle.Render(text)
+		case i >= first && i <= last && m.mark >= 0:
+			text = markStyle.Render(text)
+		case r.header:
+			text = hunkStyle.Render(text)
+		case r.Kind == diff.Added:
+			text = addedStyle.Render(text)
+		case r.Kind == diff.Deleted:
+			text = deletedStyle.Render(text)
+		}
+		lines[i] = text
+	}
+	return window(lines, m.cursor, height)
+}
+
+// rowText lays out a diff row as a line number, a +/- marker and the code,
+// padded to width so the cursor highlight spans the pane.
+func rowText(r row, width int) string {
+	if r.header {
+		return ansi.Truncate(r.Text, width, "…")
+	}
+	marker := " "
+	switch r.Kind {
+	case diff.Added:
+		marker = "+"
+	case diff.Deleted:
+		marker = "-"
+	}
+	text := fmt.Sprintf("%4d %s%s", r.Line, marker, strings.ReplaceAll(r.Text, "\t", "    "))
+	text = ansi.Truncate(text, width, "…")
+	return text + strings.Repeat(" ", max(0, width-ansi.StringWidth(text)))
+}
+
+func (m Model) conversationLines(width, height int) []string {
+	comments := m.selectedComments()
+	if len(comments) == 0 {
+		return []string{dimStyle.Render("No thread selected.")}
+	}
+
+	var lines []string
+	focus := 0
+	for i, c := range comments {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		if i == m.comment {
+			focus = len(lines)
+		}
+
+		head := authorStyle.Render("@" + c.Author)
+		if c.State == "pending" {
+			head += " " + draftStyle.Render("draft")
+		}
+		if i == m.comment && m.focus == paneConversation {
+			head = cursorStyle.Render(">") + " " + head
+		}
+		lines = append(lines, head)
+
+		wrapped := lipgloss.NewStyle().Width(max(1, width-2)).Render(strings.TrimSpace(c.Body))
+		for _, l := range strings.Split(wrapped, "\n") {
+			lines = append(lines, "  "+l)
+		}
+	}
+
+	start := max(0, min(focus, len(lines)-height))
+	return lines[start:min(len(lines), start+height)]
+}
+
+func (m Model) footer() string {
+	var status string
+	switch {
+	case m.mode == modeConfirm:
+		status = titleStyle.Render(m.prompt)
+	case m.mode == modeVerdict:
+		status = titleStyle.Render("Submit review: ") + "a approve · c comment · r request changes · esc cancel"
+	case strings.HasPrefix(m.status, "error: "):
+		status = errorStyle.Render(m.status)
+	case m.loading && m.status == "":
+		status = dimStyle.Render("Loading…")
+	default:
+		status = m.status
+	}
+
+	if m.showHelp {
+		return lipgloss.JoinVertical(lipgloss.Left, status, dimStyle.Render(helpText))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, status, dimStyle.Render(m.hints()))
+}
+
+func (m Model) hints() string {
+	switch {
+	case m.view == viewFiles:
+		return "j/k move · tab focus · v mark range · c comment · f threads · s submit · ? help · q quit"
+	case m.focus == paneDiff:
+		return "j/k line · v mark range · c comment · r reply · x resolve · tab focus · ? help · q quit"
+	case m.focus == paneConversation:
+		return "j/k comment · e edit draft · d delete draft · r reply · x resolve · tab focus · ? help · q quit"
+	default:
+		return "j/k thread · tab focus · r reply · x resolve · f files · s submit · ? help · q quit"
+	}
+}
+
+const helpText = `j/k, ↑/↓   move in the focused pane       g/G   first/last
+tab        cycle focus: list, diff, conversation
+f          switch between threads and changed files
+r          reply to the selected thread
+x          resolve or unresolve the selected thread
+c          add a draft comment on the diff line under the cursor
+v          mark the start of a multi-line range for c
+e / d      edit / delete the selected draft comment
+s          submit the pending review
+R          refresh                                q     quit`
+
+// window returns at most height lines around focus, scrolling so focus
+// stays near the middle.
+func window(lines []string, focus, height int) []string {
+	if height <= 0 {
+		return nil
+	}
+	if len(lines) <= height {
+		return lines
+	}
+	start := max(0, min(focus-height/2, len(lines)-height))
+	return lines[start : start+height]
+}